@@ -33,3 +33,14 @@ resource "mongodb_database" "database" {
     delete = "5m"
   }
 }
+
+# The collections materialise the database, so no marker collection is created
+resource "mongodb_database" "orders" {
+  name        = "orders"
+  collections = ["orders", "customers"]
+  marker_mode = "none"
+
+  # Refuse to destroy the database, and to drop it while it holds data once the protection is lifted
+  deletion_protection = true
+  drop_only_if_empty  = true
+}
@@ -15,4 +15,14 @@ data "mongodb_databases" "example_mongodb" {}
 
 output "example_mongodb" {
   value = data.mongodb_databases.example_mongodb
-}
\ No newline at end of file
+}
+
+# The databases of the application with their sizes, e.g. to alert on the disk usage
+data "mongodb_databases" "app" {
+  name_regex = "^app_"
+  statistics = true
+}
+
+output "app_size_on_disk" {
+  value = { for database in data.mongodb_databases.app.databases : database.name => database.stats.size_on_disk }
+}
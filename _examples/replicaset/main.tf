@@ -33,6 +33,11 @@ resource "mongodb_replicaset" "example" {
   name = local.replicaset_name
   members = local.members
 
+  retry = {
+    attempts      = 10
+    max_delay_sec = 60
+  }
+
   timeouts = {
     create = "5m"
     read   = "2m"
@@ -0,0 +1,420 @@
+// Package acctest starts local mongod processes for the acceptance tests.
+//
+// The tests run only when TF_ACC is set and MONGOD_PATH points to a MongoDB 6 mongod binary,
+// the only version the provider supports.
+// Every cluster listens on free localhost ports, keeps its data in a temporary directory
+// and is stopped when the test finishes.
+package acctest
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	// EnvMongodPath is the environment variable with the path to the mongod binary.
+	EnvMongodPath = "MONGOD_PATH"
+
+	// Username and Password of the root user created in every cluster.
+	Username = "root"
+	Password = "root_password"
+
+	ReplicaSetName = "rs0"
+
+	startTimeout = 30 * time.Second
+	stopTimeout  = 30 * time.Second
+	readyTimeout = 60 * time.Second
+)
+
+// Cluster is a standalone mongod or a replica set of mongod processes.
+type Cluster struct {
+	Nodes      []*Mongod
+	ReplicaSet string
+}
+
+// Mongod is a running mongod process.
+type Mongod struct {
+	Port int
+	Dir  string
+
+	cmd     *exec.Cmd
+	done    chan error
+	stopped bool
+}
+
+// PreCheck skips the test when acceptance tests are disabled or mongod is not available.
+func PreCheck(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("acceptance tests skipped unless env 'TF_ACC' set")
+	}
+
+	if mongodPath() == "" {
+		t.Skipf("acceptance tests skipped unless env '%s' set", EnvMongodPath)
+	}
+}
+
+// StartStandalone starts a standalone mongod with authorization enabled and the root user.
+func StartStandalone(t *testing.T) *Cluster {
+	t.Helper()
+
+	node := startMongod(t, "--auth")
+
+	if err := createRootUser(node); err != nil {
+		t.Fatalf("failed to create root user: %s", err)
+	}
+
+	return &Cluster{Nodes: []*Mongod{node}}
+}
+
+// StartReplicaSet starts three mongod processes with keyfile authorization and the root user
+// on the first node. When initiate is false the replica set is left for mongodb_replicaset to initiate.
+func StartReplicaSet(t *testing.T, initiate bool) *Cluster {
+	t.Helper()
+
+	keyFile := writeKeyFile(t)
+
+	// The root user is created on the first node before it joins the replica set,
+	// because an uninitiated replica set member can't accept writes.
+	first := startMongod(t)
+	if err := createRootUser(first); err != nil {
+		t.Fatalf("failed to create root user: %s", err)
+	}
+
+	if err := first.stop(); err != nil {
+		t.Fatalf("failed to stop mongod: %s", err)
+	}
+
+	args := []string{"--replSet", ReplicaSetName, "--keyFile", keyFile}
+
+	c := &Cluster{ReplicaSet: ReplicaSetName}
+	c.Nodes = append(c.Nodes, restartMongod(t, first, args...))
+
+	for range 2 {
+		c.Nodes = append(c.Nodes, startMongod(t, args...))
+	}
+
+	if initiate {
+		if err := c.initiate(); err != nil {
+			t.Fatalf("failed to initiate replica set: %s", err)
+		}
+	}
+
+	return c
+}
+
+// ConnectionString returns the provider connection string of the cluster.
+func (c *Cluster) ConnectionString() string {
+	s := fmt.Sprintf("mongodb://%s:%s@%s/admin", Username, Password, strings.Join(c.Hosts(), ","))
+	if c.ReplicaSet != "" {
+		s += "?replicaSet=" + c.ReplicaSet
+	}
+
+	return s
+}
+
+// Hosts returns host:port of every node.
+func (c *Cluster) Hosts() []string {
+	hosts := make([]string, 0, len(c.Nodes))
+	for _, node := range c.Nodes {
+		hosts = append(hosts, node.Host())
+	}
+
+	return hosts
+}
+
+// ProviderConfig returns the provider block for the cluster.
+func (c *Cluster) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "mongodb" {
+  connection_string = %q
+
+  retry = {
+    attempts  = 3
+    delay_sec = 1
+  }
+}
+`, c.ConnectionString())
+}
+
+// Client returns a client authorized as the root user, to modify the cluster outside of Terraform.
+func (c *Cluster) Client(t *testing.T) *mongo.Client {
+	t.Helper()
+
+	client, err := c.connect()
+	if err != nil {
+		t.Fatalf("failed to connect to MongoDB: %s", err)
+	}
+
+	t.Cleanup(func() {
+		_ = client.Disconnect(context.Background())
+	})
+
+	return client
+}
+
+func (c *Cluster) connect() (*mongo.Client, error) {
+	opts := options.Client().
+		ApplyURI(c.ConnectionString()).
+		SetTimeout(readyTimeout)
+
+	return mongo.Connect(opts)
+}
+
+func (c *Cluster) initiate() error {
+	ctx, cancel := context.WithTimeout(context.Background(), readyTimeout)
+	defer cancel()
+
+	client, err := c.Nodes[0].connect(true)
+	if err != nil {
+		return err
+	}
+	defer client.Disconnect(ctx)
+
+	members := bson.A{}
+	for i, host := range c.Hosts() {
+		members = append(members, bson.D{{Key: "_id", Value: i}, {Key: "host", Value: host}})
+	}
+
+	err = client.Database("admin").RunCommand(ctx, bson.D{
+		{Key: "replSetInitiate", Value: bson.D{
+			{Key: "_id", Value: c.ReplicaSet},
+			{Key: "members", Value: members},
+		}},
+	}).Err()
+	if err != nil {
+		return err
+	}
+
+	return c.waitReady(ctx, client)
+}
+
+// waitReady waits until the replica set has a primary and every member is a primary or a secondary.
+func (c *Cluster) waitReady(ctx context.Context, client *mongo.Client) error {
+	var status struct {
+		Members []memberStatus `bson:"members"`
+	}
+
+	for {
+		err := client.Database("admin").RunCommand(ctx, bson.D{{Key: "replSetGetStatus", Value: 1}}).Decode(&status)
+		if err == nil && replicaSetReady(status.Members) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("replica set is not ready: %w", ctx.Err())
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+type memberStatus struct {
+	StateStr string `bson:"stateStr"`
+}
+
+func replicaSetReady(members []memberStatus) bool {
+	primary := false
+	for _, member := range members {
+		switch member.StateStr {
+		case "PRIMARY":
+			primary = true
+		case "SECONDARY":
+		default:
+			return false
+		}
+	}
+
+	return primary
+}
+
+// Host returns host:port of the node.
+func (m *Mongod) Host() string {
+	return net.JoinHostPort("127.0.0.1", strconv.Itoa(m.Port))
+}
+
+// connect connects directly to the node, as the root user when auth is true.
+func (m *Mongod) connect(auth bool) (*mongo.Client, error) {
+	uri := fmt.Sprintf("mongodb://%s/?directConnection=true", m.Host())
+	if auth {
+		uri = fmt.Sprintf("mongodb://%s:%s@%s/admin?directConnection=true", Username, Password, m.Host())
+	}
+
+	return mongo.Connect(options.Client().ApplyURI(uri).SetTimeout(startTimeout))
+}
+
+func startMongod(t *testing.T, args ...string) *Mongod {
+	t.Helper()
+
+	m := &Mongod{
+		Port: freePort(t),
+		Dir:  t.TempDir(),
+	}
+
+	return runMongod(t, m, args...)
+}
+
+func restartMongod(t *testing.T, m *Mongod, args ...string) *Mongod {
+	t.Helper()
+
+	return runMongod(t, &Mongod{Port: m.Port, Dir: m.Dir}, args...)
+}
+
+func runMongod(t *testing.T, m *Mongod, args ...string) *Mongod {
+	t.Helper()
+
+	args = append([]string{
+		"--port", strconv.Itoa(m.Port),
+		"--bind_ip", "127.0.0.1",
+		"--dbpath", m.Dir,
+		"--logpath", filepath.Join(m.Dir, "mongod.log"),
+		"--logappend",
+	}, args...)
+
+	m.cmd = exec.Command(mongodPath(), args...)
+	m.done = make(chan error, 1)
+
+	if err := m.cmd.Start(); err != nil {
+		t.Fatalf("failed to start mongod: %s", err)
+	}
+
+	go func() {
+		m.done <- m.cmd.Wait()
+	}()
+
+	t.Cleanup(func() {
+		if t.Failed() {
+			t.Logf("mongod %s log: %s", m.Host(), filepath.Join(m.Dir, "mongod.log"))
+		}
+
+		if err := m.stop(); err != nil {
+			t.Errorf("failed to stop mongod %s: %s", m.Host(), err)
+		}
+	})
+
+	if err := m.waitStarted(); err != nil {
+		t.Fatalf("mongod %s failed to start: %s", m.Host(), err)
+	}
+
+	return m
+}
+
+// waitStarted pings the node until it accepts connections or the process exits.
+func (m *Mongod) waitStarted() error {
+	ctx, cancel := context.WithTimeout(context.Background(), startTimeout)
+	defer cancel()
+
+	for {
+		select {
+		case err := <-m.done:
+			m.done <- err
+			return fmt.Errorf("mongod exited: %v", err)
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(200 * time.Millisecond):
+		}
+
+		client, err := m.connect(false)
+		if err != nil {
+			continue
+		}
+
+		err = client.Ping(ctx, nil)
+		_ = client.Disconnect(ctx)
+
+		if err == nil {
+			return nil
+		}
+	}
+}
+
+// stop terminates the process gracefully and kills it if it doesn't exit in time. It is safe to call twice.
+func (m *Mongod) stop() error {
+	if m.stopped {
+		return nil
+	}
+
+	m.stopped = true
+
+	if err := m.cmd.Process.Signal(os.Interrupt); err != nil {
+		_ = m.cmd.Process.Kill()
+	}
+
+	select {
+	case <-m.done:
+		return nil
+	case <-time.After(stopTimeout):
+		if err := m.cmd.Process.Kill(); err != nil {
+			return err
+		}
+
+		<-m.done
+
+		return fmt.Errorf("killed after %s", stopTimeout)
+	}
+}
+
+// createRootUser creates the root user through the localhost exception.
+func createRootUser(m *Mongod) error {
+	ctx, cancel := context.WithTimeout(context.Background(), startTimeout)
+	defer cancel()
+
+	client, err := m.connect(false)
+	if err != nil {
+		return err
+	}
+	defer client.Disconnect(ctx)
+
+	return client.Database("admin").RunCommand(ctx, bson.D{
+		{Key: "createUser", Value: Username},
+		{Key: "pwd", Value: Password},
+		{Key: "roles", Value: bson.A{bson.D{{Key: "role", Value: "root"}, {Key: "db", Value: "admin"}}}},
+	}).Err()
+}
+
+func writeKeyFile(t *testing.T) string {
+	t.Helper()
+
+	key := make([]byte, 48)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("failed to generate keyfile: %s", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "keyfile")
+	if err := os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(key)), 0o400); err != nil {
+		t.Fatalf("failed to write keyfile: %s", err)
+	}
+
+	return path
+}
+
+func freePort(t *testing.T) int {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %s", err)
+	}
+	defer l.Close()
+
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+func mongodPath() string {
+	return os.Getenv(EnvMongodPath)
+}
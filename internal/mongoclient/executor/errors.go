@@ -0,0 +1,182 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/avast/retry-go/v4"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
+)
+
+// transientLabels are server error labels which mean the command may succeed if it is retried.
+var transientLabels = []string{
+	"RetryableWriteError",
+	"TransientTransactionError",
+	"NetworkError",
+	"NetworkTimeoutError",
+	"ResetPool",
+}
+
+// transientCodes are server error codes which mean the command may succeed if it is retried,
+// mostly caused by elections, shutdowns and network problems between the cluster members.
+var transientCodes = map[int]string{
+	6:     "HostUnreachable",
+	7:     "HostNotFound",
+	24:    "LockTimeout",
+	89:    "NetworkTimeout",
+	91:    "ShutdownInProgress",
+	112:   "WriteConflict",
+	133:   "FailedToSatisfyReadPreference",
+	189:   "PrimarySteppedDown",
+	202:   "NetworkInterfaceExceededTimeLimit",
+	262:   "ExceededTimeLimit",
+	9001:  "SocketException",
+	10107: "NotWritablePrimary",
+	11600: "InterruptedAtShutdown",
+	11602: "InterruptedDueToReplStateChange",
+	13435: "NotPrimaryNoSecondaryOk",
+	13436: "NotPrimaryOrSecondary",
+}
+
+// permanentCodes are server error codes which will not change on retry. They are checked before
+// the transient labels, because the server may label e.g. an authentication failure as a network error.
+var permanentCodes = map[int]string{
+	2:     "BadValue",
+	4:     "NoSuchKey",
+	9:     "FailedToParse",
+	11:    "UserNotFound",
+	13:    "Unauthorized",
+	14:    "TypeMismatch",
+	18:    "AuthenticationFailed",
+	20:    "IllegalOperation",
+	23:    "AlreadyInitialized",
+	26:    "NamespaceNotFound",
+	31:    "RoleNotFound",
+	40:    "ConflictingUpdateOperators",
+	48:    "NamespaceExists",
+	59:    "CommandNotFound",
+	72:    "InvalidOptions",
+	76:    "NoReplicationEnabled",
+	93:    "InvalidReplicaSetConfig",
+	94:    "NotYetInitialized",
+	103:   "NewReplicaSetConfigurationIncompatible",
+	11000: "DuplicateKey",
+	51003: "UserAlreadyExists",
+}
+
+// IsRetryable reports whether the operation which failed with err may succeed on the next attempt.
+//
+// Errors marked with Unrecoverable, context cancellation and server errors with a known permanent code
+// are never retried. Server errors with a transient label or code, network errors, timeouts, server
+// selection failures and errors marked with Transient are retried. Any other error is considered permanent,
+// e.g. an authentication failure during the connection handshake or a reply which cannot be decoded.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if !retry.IsRecoverable(err) {
+		return false
+	}
+
+	var te transientError
+	if errors.As(err, &te) {
+		return true
+	}
+
+	// The driver reports a server selection timeout as a server selection error wrapping
+	// context.DeadlineExceeded, so it is checked before the context errors.
+	var sse topology.ServerSelectionError
+	if errors.As(err, &sse) {
+		return true
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var se mongo.ServerError
+	if errors.As(err, &se) {
+		for _, code := range se.ErrorCodes() {
+			if _, ok := permanentCodes[code]; ok {
+				return false
+			}
+		}
+
+		for _, label := range transientLabels {
+			if se.HasErrorLabel(label) {
+				return true
+			}
+		}
+
+		for _, code := range se.ErrorCodes() {
+			if _, ok := transientCodes[code]; ok {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return mongo.IsNetworkError(err) || mongo.IsTimeout(err)
+}
+
+// transientError is an error of a provider-level check which is expected to resolve itself.
+type transientError struct {
+	err error
+}
+
+func (e transientError) Error() string {
+	return e.err.Error()
+}
+
+func (e transientError) Unwrap() error {
+	return e.err
+}
+
+// describeError returns the name and code of the server error, e.g. "Unauthorized (code 13)".
+// It returns an empty string if err is not a server error.
+func describeError(err error) string {
+	var ce mongo.CommandError
+	if errors.As(err, &ce) && ce.Name != "" {
+		return fmt.Sprintf("%s (code %d)", ce.Name, ce.Code)
+	}
+
+	var se mongo.ServerError
+	if !errors.As(err, &se) {
+		return ""
+	}
+
+	for _, code := range se.ErrorCodes() {
+		if name, ok := permanentCodes[code]; ok {
+			return fmt.Sprintf("%s (code %d)", name, code)
+		}
+
+		if name, ok := transientCodes[code]; ok {
+			return fmt.Sprintf("%s (code %d)", name, code)
+		}
+	}
+
+	if code, ok := errorCode(err); ok {
+		return fmt.Sprintf("code %d", code)
+	}
+
+	return ""
+}
+
+// errorCode returns the first server error code of err.
+func errorCode(err error) (int, bool) {
+	var se mongo.ServerError
+	if !errors.As(err, &se) {
+		return 0, false
+	}
+
+	codes := se.ErrorCodes()
+	if len(codes) == 0 {
+		return 0, false
+	}
+
+	return codes[0], true
+}
@@ -0,0 +1,104 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "no error", err: nil, want: false},
+
+		// Server errors
+		{name: "transient code", err: mongo.CommandError{Code: 189, Name: "PrimarySteppedDown"}, want: true},
+		{name: "transient code wrapped", err: fmt.Errorf("listShards failed: %w", mongo.CommandError{Code: 91}), want: true},
+		{name: "permanent code", err: mongo.CommandError{Code: 13, Name: "Unauthorized"}, want: false},
+		{name: "no such key", err: mongo.CommandError{Code: 4, Name: "NoSuchKey"}, want: false},
+		{name: "unknown code", err: mongo.CommandError{Code: 12345}, want: false},
+
+		// Labeled errors
+		{name: "transient label", err: mongo.CommandError{Code: 12345, Labels: []string{"RetryableWriteError"}}, want: true},
+		{name: "network error label", err: mongo.CommandError{Labels: []string{"NetworkError"}}, want: true},
+		{name: "permanent code before transient label", err: mongo.CommandError{Code: 18, Labels: []string{"NetworkError"}}, want: false},
+		{name: "unknown label", err: mongo.CommandError{Code: 12345, Labels: []string{"SomeLabel"}}, want: false},
+
+		// Write errors
+		{
+			name: "transient write concern error",
+			err:  mongo.WriteException{WriteConcernError: &mongo.WriteConcernError{Code: 11602}},
+			want: true,
+		},
+		{
+			name: "permanent write concern error",
+			err:  mongo.WriteException{WriteConcernError: &mongo.WriteConcernError{Code: 72}},
+			want: false,
+		},
+		{
+			name: "duplicate key write error",
+			err:  mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000}}},
+			want: false,
+		},
+		{
+			name: "labeled write exception",
+			err:  mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 112}}, Labels: []string{"TransientTransactionError"}},
+			want: true,
+		},
+
+		// Non-server errors
+		{name: "transient provider check", err: Transient(errors.New("replica set not ready")), want: true},
+		{name: "transient wrapped", err: fmt.Errorf("update failed: %w", Transient(errors.New("replica set not ready"))), want: true},
+		{name: "unknown error", err: errors.New("replica set not ready"), want: false},
+		{name: "decode error", err: fmt.Errorf("failed to decode: %w", io.ErrUnexpectedEOF), want: false},
+		{name: "client disconnected", err: mongo.ErrClientDisconnected, want: false},
+		{name: "server selection timeout", err: topology.ServerSelectionError{Wrapped: context.DeadlineExceeded}, want: true},
+		{name: "network timeout", err: &net.OpError{Op: "read", Err: os.ErrDeadlineExceeded}, want: true},
+		{name: "context canceled", err: fmt.Errorf("ping failed: %w", context.Canceled), want: false},
+		{name: "context deadline", err: context.DeadlineExceeded, want: false},
+
+		// Unrecoverable
+		{name: "unrecoverable", err: Unrecoverable(errors.New("database app already exists")), want: false},
+		{name: "unrecoverable transient server error", err: Unrecoverable(mongo.CommandError{Code: 189}), want: false},
+		{name: "unrecoverable wrapped", err: fmt.Errorf("create failed: %w", Unrecoverable(errors.New("invalid"))), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Fatalf("expected IsRetryable %t for %v, got %t", tt.want, tt.err, got)
+			}
+		})
+	}
+}
+
+func TestDescribeError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "command error with name", err: mongo.CommandError{Code: 13, Name: "Unauthorized"}, want: "Unauthorized (code 13)"},
+		{name: "known code without name", err: mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000}}}, want: "DuplicateKey (code 11000)"},
+		{name: "unknown code", err: mongo.WriteException{WriteConcernError: &mongo.WriteConcernError{Code: 12345}}, want: "code 12345"},
+		{name: "not a server error", err: errors.New("replica set not ready"), want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := describeError(tt.err); got != tt.want {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
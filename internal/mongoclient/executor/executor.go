@@ -0,0 +1,220 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/avast/retry-go/v4"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+var (
+	defaultContextTimeout = 1 * time.Second
+)
+
+// ConnectMode defines how the executor connects to the MongoDB.
+type ConnectMode int
+
+const (
+	// ConnectDefault connects using the connection string as is.
+	ConnectDefault ConnectMode = iota
+	// ConnectReplicaSet connects using the connection string and requires the replicaSet option to be set.
+	ConnectReplicaSet
+	// ConnectDirect connects directly to the first host of the connection string, or to the host of the operation,
+	// ignoring the replicaSet option. It is required for commands which must be executed before the replica set
+	// is initialized or on a single member.
+	ConnectDirect
+)
+
+// Operation describes a single provider operation executed against the MongoDB.
+type Operation struct {
+	Name string      // Human-readable name of the operation, e.g. "create database"
+	Mode ConnectMode // How to connect to the MongoDB
+	Host string      // Host of a direct connection, by default the first host of the connection string
+}
+
+// Func is the command logic of an operation. It receives a connected client, which is disconnected by the executor.
+type Func func(ctx context.Context, client *mongo.Client) error
+
+// Executor owns connection acquisition and retry policy for all MongoDB operations.
+type Executor struct {
+	Uri       string
+	Policy    Policy
+	TraceFile string // Optional path of the JSON lines file with driver events of every operation
+}
+
+// WithRetry returns a copy of the executor with the retry policy overridden by the resource configuration.
+func (e *Executor) WithRetry(override *types.Retry) *Executor {
+	c := *e
+	c.Policy = e.Policy.Merge(override)
+
+	return &c
+}
+
+// Do connects to the MongoDB and runs fn, retrying the whole attempt (including connection) on failure.
+// Only errors classified as transient by IsRetryable are retried, all others are returned immediately.
+func (e *Executor) Do(ctx context.Context, op Operation, fn Func) error {
+	var attempts uint
+	var lastErr error
+
+	ctx = withLogging(ctx, op)
+	start := time.Now()
+
+	t := newTracer(op, e.TraceFile != "")
+	ctx = withTracer(ctx, t)
+
+	opts := append(
+		e.Policy.options(),
+		retry.Context(ctx),
+		retry.LastErrorOnly(true),
+		retry.RetryIf(IsRetryable),
+		retry.OnRetry(func(attempt uint, err error) {
+			fields := map[string]interface{}{
+				"attempt": attempt + 1,
+				"error":   err.Error(),
+			}
+
+			if code, ok := errorCode(err); ok {
+				fields["error_code"] = code
+			}
+
+			tflog.SubsystemWarn(ctx, logSubsystem, "MongoDB operation attempt failed, retrying", fields)
+		}),
+	)
+
+	err := retry.Do(
+		func() error {
+			attempts++
+
+			attemptCtx := withAttempt(ctx, attempts)
+
+			c, err := e.connect(attemptCtx, op.Mode, op.Host)
+			if err != nil {
+				lastErr = fmt.Errorf("connection to MongoDB failed with error: %w", err)
+
+				return lastErr
+			}
+
+			defer Disconnect(attemptCtx, c)
+
+			lastErr = fn(attemptCtx, c)
+
+			return lastErr
+		},
+		opts...,
+	)
+
+	// retry-go strips the Unrecoverable marker from the error it returns, keep it for IsRetryable and the callers
+	if err != nil && !retry.IsRecoverable(lastErr) && retry.IsRecoverable(err) {
+		err = Unrecoverable(err)
+	}
+
+	t.finish(ctx, e.TraceFile, attempts, err)
+
+	fields := map[string]interface{}{
+		"attempts":    attempts,
+		"duration_ms": time.Since(start).Milliseconds(),
+	}
+
+	if err == nil {
+		tflog.SubsystemDebug(ctx, logSubsystem, "MongoDB operation succeeded", fields)
+
+		return nil
+	}
+
+	fields["error"] = err.Error()
+	if code, ok := errorCode(err); ok {
+		fields["error_code"] = code
+	}
+
+	tflog.SubsystemError(ctx, logSubsystem, "MongoDB operation failed", fields)
+
+	if !IsRetryable(err) {
+		if d := describeError(err); d != "" {
+			return fmt.Errorf("%s failed with non-retryable error %s: %w", op.Name, d, err)
+		}
+
+		return err
+	}
+
+	return fmt.Errorf("%s failed after %d attempts: %w", op.Name, attempts, err)
+}
+
+// Connect returns a connected and pinged client. The caller is responsible for disconnecting it.
+func (e *Executor) Connect(ctx context.Context, mode ConnectMode) (*mongo.Client, error) {
+	return e.connect(ctx, mode, "")
+}
+
+// connect returns a connected and pinged client, connected directly to the host in the ConnectDirect mode
+// if it is set. The credentials and other options of the connection string are kept.
+func (e *Executor) connect(ctx context.Context, mode ConnectMode, host string) (*mongo.Client, error) {
+	t := tracerFromContext(ctx)
+
+	opts := options.Client().ApplyURI(e.Uri).SetMonitor(commandMonitor(t))
+	if t != nil {
+		opts.SetPoolMonitor(t.poolMonitor()).SetServerMonitor(t.serverMonitor())
+	}
+
+	switch mode {
+	case ConnectReplicaSet:
+		if opts.ReplicaSet == nil {
+			return nil, Unrecoverable(fmt.Errorf("you can't use direct connection when working with replica set"))
+		}
+	case ConnectDirect:
+		if host == "" {
+			host = opts.Hosts[0]
+		}
+
+		opts.ReplicaSet = nil
+		opts.Hosts = []string{host}
+		opts.SetDirect(true)
+	}
+
+	// mongo.Connect does not perform any I/O, so its errors are caused by invalid client options
+	client, err := mongo.Connect(opts)
+	if err != nil {
+		return nil, Unrecoverable(err)
+	}
+
+	err = client.Ping(ctx, nil)
+	if err != nil {
+		Disconnect(ctx, client)
+
+		return nil, fmt.Errorf("failed to ping MongoDB: %w", err)
+	}
+
+	return client, nil
+}
+
+// Hosts returns the hosts of the connection string.
+func (e *Executor) Hosts() ([]string, error) {
+	opts := options.Client().ApplyURI(e.Uri)
+	if err := opts.Validate(); err != nil {
+		return nil, Unrecoverable(err)
+	}
+
+	return opts.Hosts, nil
+}
+
+// Disconnect closes the client connection, ignoring errors.
+func Disconnect(ctx context.Context, client *mongo.Client) {
+	disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
+	_ = client.Disconnect(disconnectCtx)
+	cancel()
+}
+
+// Unrecoverable marks the error as non-retryable, so Do returns it immediately.
+func Unrecoverable(err error) error {
+	return retry.Unrecoverable(err)
+}
+
+// Transient marks the error of a provider-level check as retryable, e.g. a replica set which is not ready yet.
+// Errors which are neither server, network nor timeout errors are otherwise returned immediately.
+func Transient(err error) error {
+	return transientError{err: err}
+}
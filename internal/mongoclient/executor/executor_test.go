@@ -0,0 +1,122 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func TestExecutorDo(t *testing.T) {
+	tests := []struct {
+		name          string
+		attempts      uint
+		err           error
+		wantCalls     int
+		wantErr       string
+		wantRetryable bool
+	}{
+		{name: "success", attempts: 3, wantCalls: 1},
+		{name: "retryable error", attempts: 3, err: Transient(errors.New("replica set not ready")), wantCalls: 3, wantErr: "test failed after 3 attempts: replica set not ready", wantRetryable: true},
+		{name: "zero attempts run once", attempts: 0, err: Transient(errors.New("replica set not ready")), wantCalls: 1, wantErr: "test failed after 1 attempts: replica set not ready", wantRetryable: true},
+		{name: "unknown error", attempts: 3, err: errors.New("unexpected reply"), wantCalls: 1, wantErr: "unexpected reply"},
+		{name: "unrecoverable error", attempts: 3, err: Unrecoverable(errors.New("database app already exists")), wantCalls: 1, wantErr: "database app already exists"},
+		{name: "permanent server error", attempts: 3, err: mongo.CommandError{Code: 13, Name: "Unauthorized", Message: "not authorized"}, wantCalls: 1, wantErr: "test failed with non-retryable error Unauthorized (code 13)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+
+			e := &Executor{
+				Uri:    srv.URI(),
+				Policy: Policy{Attempts: tt.attempts, Delay: time.Millisecond, Backoff: types.RetryBackoffFixed},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			calls := 0
+			err := e.Do(ctx, Operation{Name: "test"}, func(context.Context, *mongo.Client) error {
+				calls++
+
+				return tt.err
+			})
+
+			if calls != tt.wantCalls {
+				t.Fatalf("expected %d calls, got %d", tt.wantCalls, calls)
+			}
+
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+			}
+
+			if IsRetryable(err) != tt.wantRetryable {
+				t.Fatalf("expected the returned error retryable: %t, got %t", tt.wantRetryable, IsRetryable(err))
+			}
+		})
+	}
+}
+
+func TestExecutorDoAuthenticationFailure(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler mongotest.Handler
+	}{
+		{name: "authentication failed", handler: mongotest.Fail(18)},
+		{name: "invalid server reply", handler: mongotest.Reply(
+			bson.E{Key: "conversationId", Value: 1},
+			bson.E{Key: "done", Value: false},
+			bson.E{Key: "payload", Value: bson.Binary{Data: []byte("invalid")}},
+		)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.Handle("saslStart", tt.handler)
+
+			e := &Executor{
+				Uri:    strings.Replace(srv.URI(), "mongodb://", "mongodb://admin:secret@", 1),
+				Policy: Policy{Attempts: 3, Delay: time.Millisecond, Backoff: types.RetryBackoffFixed},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			calls := 0
+			err := e.Do(ctx, Operation{Name: "test"}, func(context.Context, *mongo.Client) error {
+				calls++
+
+				return nil
+			})
+
+			if err == nil {
+				t.Fatal("expected an authentication error")
+			}
+
+			if calls != 0 {
+				t.Fatalf("expected no calls, got %d", calls)
+			}
+
+			if got := srv.Calls("saslStart"); got != 1 {
+				t.Fatalf("expected 1 authentication attempt, got %d: %s", got, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package executor
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/event"
+)
+
+const (
+	logSubsystem = "mongodb"
+	maskedValue  = "***"
+)
+
+// sensitiveFields are command and log fields which values must never be logged.
+var sensitiveFields = []string{"pwd", "password", "clientKey", "serverKey"}
+
+// authCommandFields are fields masked only in the authentication commands, where they hold credentials.
+// In other commands e.g. key is a shard or index key, which is needed to diagnose them.
+var authCommandFields = map[string][]string{
+	"saslStart":    {"payload"},
+	"saslContinue": {"payload"},
+	"authenticate": {"key"},
+}
+
+// withLogging returns a context with the mongodb log subsystem, which is visible with TF_LOG=DEBUG.
+// All log entries of the operation are tagged with its name and the root fields (resource type, request ID).
+func withLogging(ctx context.Context, op Operation) context.Context {
+	ctx = tflog.NewSubsystem(ctx, logSubsystem, tflog.WithRootFields())
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, logSubsystem, sensitiveFields...)
+	ctx = tflog.SubsystemSetField(ctx, logSubsystem, "operation", op.Name)
+
+	return ctx
+}
+
+// withAttempt tags all log entries of the current retry attempt with its number.
+func withAttempt(ctx context.Context, attempt uint) context.Context {
+	return tflog.SubsystemSetField(ctx, logSubsystem, "attempt", attempt)
+}
+
+// commandMonitor logs every command sent to the MongoDB and passes it to the tracer, if any.
+// The context of the events is the context of the operation, so the entries contain the operation
+// name and the attempt number.
+func commandMonitor(t *tracer) *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, e *event.CommandStartedEvent) {
+			if t != nil {
+				t.commandStarted(e)
+			}
+
+			tflog.SubsystemTrace(ctx, logSubsystem, "MongoDB command started", map[string]interface{}{
+				"command":    e.CommandName,
+				"database":   e.DatabaseName,
+				"host":       hostFromConnectionID(e.ConnectionID),
+				"request_id": e.RequestID,
+				"body":       redactCommand(e.Command),
+			})
+		},
+		Succeeded: func(ctx context.Context, e *event.CommandSucceededEvent) {
+			if t != nil {
+				t.commandSucceeded(e)
+			}
+
+			tflog.SubsystemDebug(ctx, logSubsystem, "MongoDB command succeeded", map[string]interface{}{
+				"command":     e.CommandName,
+				"database":    e.DatabaseName,
+				"host":        hostFromConnectionID(e.ConnectionID),
+				"request_id":  e.RequestID,
+				"duration_ms": e.Duration.Milliseconds(),
+			})
+		},
+		Failed: func(ctx context.Context, e *event.CommandFailedEvent) {
+			if t != nil {
+				t.commandFailed(e)
+			}
+
+			fields := map[string]interface{}{
+				"command":     e.CommandName,
+				"database":    e.DatabaseName,
+				"host":        hostFromConnectionID(e.ConnectionID),
+				"request_id":  e.RequestID,
+				"duration_ms": e.Duration.Milliseconds(),
+				"error":       e.Failure.Error(),
+			}
+
+			if code, ok := errorCode(e.Failure); ok {
+				fields["error_code"] = code
+			}
+
+			tflog.SubsystemDebug(ctx, logSubsystem, "MongoDB command failed", fields)
+		},
+	}
+}
+
+// hostFromConnectionID strips the connection number from the driver connection ID, e.g. "localhost:27017[-3]".
+func hostFromConnectionID(connectionID string) string {
+	if i := strings.LastIndex(connectionID, "["); i > 0 {
+		return connectionID[:i]
+	}
+
+	return connectionID
+}
+
+// redactCommand returns the command as extended JSON with the values of sensitive fields masked,
+// including the credentials of an authentication command.
+func redactCommand(command bson.Raw) string {
+	var doc bson.D
+
+	if err := bson.Unmarshal(command, &doc); err != nil {
+		return ""
+	}
+
+	fields := sensitiveFields
+	if len(doc) > 0 {
+		fields = append(slices.Clone(sensitiveFields), authCommandFields[doc[0].Key]...)
+	}
+
+	b, err := bson.MarshalExtJSON(redactDocument(doc, fields), false, false)
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
+
+func redactDocument(doc bson.D, fields []string) bson.D {
+	redacted := make(bson.D, 0, len(doc))
+
+	for _, e := range doc {
+		if slices.Contains(fields, e.Key) {
+			redacted = append(redacted, bson.E{Key: e.Key, Value: maskedValue})
+			continue
+		}
+
+		redacted = append(redacted, bson.E{Key: e.Key, Value: redactValue(e.Value, fields)})
+	}
+
+	return redacted
+}
+
+func redactValue(value interface{}, fields []string) interface{} {
+	switch v := value.(type) {
+	case bson.D:
+		return redactDocument(v, fields)
+	case bson.A:
+		redacted := make(bson.A, 0, len(v))
+		for _, i := range v {
+			redacted = append(redacted, redactValue(i, fields))
+		}
+
+		return redacted
+	default:
+		return v
+	}
+}
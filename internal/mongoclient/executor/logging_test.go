@@ -0,0 +1,68 @@
+package executor
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestRedactCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		command bson.D
+		want    string
+	}{
+		{
+			name:    "createUser password",
+			command: bson.D{{Key: "createUser", Value: "app"}, {Key: "pwd", Value: "secret"}},
+			want:    `{"createUser":"app","pwd":"***"}`,
+		},
+		{
+			name: "nested credentials",
+			command: bson.D{{Key: "replSetReconfig", Value: bson.D{
+				{Key: "members", Value: bson.A{bson.D{{Key: "password", Value: "secret"}}}},
+			}}},
+			want: `{"replSetReconfig":{"members":[{"password":"***"}]}}`,
+		},
+		{
+			name:    "saslStart payload",
+			command: bson.D{{Key: "saslStart", Value: 1}, {Key: "mechanism", Value: "SCRAM-SHA-256"}, {Key: "payload", Value: "n,,n=app,r=abc"}},
+			want:    `{"saslStart":1,"mechanism":"SCRAM-SHA-256","payload":"***"}`,
+		},
+		{
+			name:    "saslContinue payload",
+			command: bson.D{{Key: "saslContinue", Value: 1}, {Key: "conversationId", Value: 1}, {Key: "payload", Value: "c=biws,p=proof"}},
+			want:    `{"saslContinue":1,"conversationId":1,"payload":"***"}`,
+		},
+		{
+			name:    "shard key",
+			command: bson.D{{Key: "shardCollection", Value: "app.orders"}, {Key: "key", Value: bson.D{{Key: "tenant_id", Value: 1}}}},
+			want:    `{"shardCollection":"app.orders","key":{"tenant_id":1}}`,
+		},
+		{
+			name: "index key",
+			command: bson.D{{Key: "createIndexes", Value: "orders"}, {Key: "indexes", Value: bson.A{
+				bson.D{{Key: "key", Value: bson.D{{Key: "created_at", Value: -1}}}, {Key: "name", Value: "created_at_-1"}},
+			}}},
+			want: `{"createIndexes":"orders","indexes":[{"key":{"created_at":-1},"name":"created_at_-1"}]}`,
+		},
+		{
+			name:    "payload outside authentication",
+			command: bson.D{{Key: "insert", Value: "events"}, {Key: "documents", Value: bson.A{bson.D{{Key: "payload", Value: "order created"}}}}},
+			want:    `{"insert":"events","documents":[{"payload":"order created"}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command, err := bson.Marshal(tt.command)
+			if err != nil {
+				t.Fatalf("failed to marshal command: %s", err)
+			}
+
+			if got := redactCommand(command); got != tt.want {
+				t.Fatalf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package executor
+
+import (
+	"time"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/avast/retry-go/v4"
+)
+
+// Policy defines how many times and how often a failed operation is retried.
+type Policy struct {
+	Attempts  uint
+	Delay     time.Duration
+	MaxDelay  time.Duration // Zero means no cap
+	MaxJitter time.Duration // Zero means no jitter
+	Backoff   string        // types.RetryBackoffFixed or types.RetryBackoffExponential
+}
+
+// DefaultPolicy returns the policy used when nothing is configured.
+func DefaultPolicy() Policy {
+	return Policy{
+		Attempts: types.RetryAttempts,
+		Delay:    types.RetryDelaySec * time.Second,
+		Backoff:  types.RetryBackoffExponential,
+	}
+}
+
+// Merge returns a copy of the policy with the fields set in override replaced. Attempts below one are ignored.
+func (p Policy) Merge(override *types.Retry) Policy {
+	if override == nil {
+		return p
+	}
+
+	if override.Attempts != nil && *override.Attempts > 0 {
+		p.Attempts = uint(*override.Attempts)
+	}
+
+	if override.DelaySec != nil {
+		p.Delay = time.Duration(*override.DelaySec) * time.Second
+	}
+
+	if override.MaxDelaySec != nil {
+		p.MaxDelay = time.Duration(*override.MaxDelaySec) * time.Second
+	}
+
+	if override.JitterSec != nil {
+		p.MaxJitter = time.Duration(*override.JitterSec) * time.Second
+	}
+
+	if override.Backoff != nil {
+		p.Backoff = *override.Backoff
+	}
+
+	return p
+}
+
+// options converts the policy to retry-go options. At least one attempt is made, as retry-go retries
+// zero attempts until the function succeeds.
+func (p Policy) options() []retry.Option {
+	delayType := retry.BackOffDelay
+	if p.Backoff == types.RetryBackoffFixed {
+		delayType = retry.FixedDelay
+	}
+
+	if p.MaxJitter > 0 {
+		delayType = retry.CombineDelay(delayType, retry.RandomDelay)
+	}
+
+	opts := []retry.Option{
+		retry.Attempts(max(p.Attempts, 1)),
+		retry.DelayType(delayType),
+		retry.Delay(p.Delay),
+		retry.MaxJitter(p.MaxJitter),
+	}
+
+	if p.MaxDelay > 0 {
+		opts = append(opts, retry.MaxDelay(p.MaxDelay))
+	}
+
+	return opts
+}
@@ -0,0 +1,69 @@
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+func TestPolicyMerge(t *testing.T) {
+	int64Ptr := func(v int64) *int64 { return &v }
+	stringPtr := func(v string) *string { return &v }
+
+	provider := DefaultPolicy().Merge(&types.Retry{
+		Attempts:  int64Ptr(10),
+		JitterSec: int64Ptr(1),
+		Backoff:   stringPtr(types.RetryBackoffFixed),
+	})
+
+	tests := []struct {
+		name     string
+		policy   Policy
+		override *types.Retry
+		want     Policy
+	}{
+		{
+			name:   "defaults",
+			policy: DefaultPolicy(),
+			want:   Policy{Attempts: 5, Delay: 3 * time.Second, Backoff: types.RetryBackoffExponential},
+		},
+		{
+			name:   "provider",
+			policy: provider,
+			want:   Policy{Attempts: 10, Delay: 3 * time.Second, MaxJitter: time.Second, Backoff: types.RetryBackoffFixed},
+		},
+		{
+			name:     "resource overrides provider",
+			policy:   provider,
+			override: &types.Retry{Attempts: int64Ptr(2), DelaySec: int64Ptr(1), MaxDelaySec: int64Ptr(30)},
+			want: Policy{
+				Attempts:  2,
+				Delay:     time.Second,
+				MaxDelay:  30 * time.Second,
+				MaxJitter: time.Second,
+				Backoff:   types.RetryBackoffFixed,
+			},
+		},
+		{
+			name:     "resource without fields",
+			policy:   provider,
+			override: &types.Retry{},
+			want:     provider,
+		},
+		{
+			name:     "zero attempts are ignored",
+			policy:   provider,
+			override: &types.Retry{Attempts: int64Ptr(0)},
+			want:     provider,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Merge(tt.override); got != tt.want {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.mongodb.org/mongo-driver/v2/event"
+)
+
+// traceFileMutex serializes writes to the trace file, because resources are applied in parallel.
+var traceFileMutex sync.Mutex
+
+type tracerKey struct{}
+
+// tracer collects driver events of a single operation to emit a summary when the operation finishes.
+// The driver calls the monitors from its own goroutines, so all fields are guarded by mu.
+type tracer struct {
+	mu sync.Mutex
+
+	operation string
+	start     time.Time
+	keep      bool // Whether to keep individual events for the trace file
+
+	commands         map[string]int
+	commandFailures  map[string]int
+	commandsDuration time.Duration
+
+	poolCheckouts        int
+	poolCheckoutFailures int
+	connectionsCreated   int
+	poolsCleared         int
+
+	topologyChanges   int
+	serverChanges     int
+	heartbeatFailures int
+
+	events []traceEvent
+}
+
+// traceEvent is a single driver event written to the trace file.
+type traceEvent struct {
+	Time       time.Time `json:"time"`
+	Type       string    `json:"type"`
+	Name       string    `json:"name,omitempty"`
+	Database   string    `json:"database,omitempty"`
+	Host       string    `json:"host,omitempty"`
+	DurationMs int64     `json:"duration_ms,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// traceRecord is a line of the trace file, written when an operation finishes.
+type traceRecord struct {
+	Operation  string       `json:"operation"`
+	Start      time.Time    `json:"start"`
+	DurationMs int64        `json:"duration_ms"`
+	Attempts   uint         `json:"attempts"`
+	Error      string       `json:"error,omitempty"`
+	Summary    traceSummary `json:"summary"`
+	Events     []traceEvent `json:"events"`
+}
+
+type traceSummary struct {
+	Commands             map[string]int `json:"commands"`
+	CommandFailures      map[string]int `json:"command_failures"`
+	CommandsDurationMs   int64          `json:"commands_duration_ms"`
+	PoolCheckouts        int            `json:"pool_checkouts"`
+	PoolCheckoutFailures int            `json:"pool_checkout_failures"`
+	ConnectionsCreated   int            `json:"connections_created"`
+	PoolsCleared         int            `json:"pools_cleared"`
+	TopologyChanges      int            `json:"topology_changes"`
+	ServerChanges        int            `json:"server_changes"`
+	HeartbeatFailures    int            `json:"heartbeat_failures"`
+}
+
+func newTracer(op Operation, keepEvents bool) *tracer {
+	return &tracer{
+		operation:       op.Name,
+		start:           time.Now(),
+		keep:            keepEvents,
+		commands:        map[string]int{},
+		commandFailures: map[string]int{},
+	}
+}
+
+// withTracer stores the tracer in the context, so Connect can attach it to the clients of the operation.
+func withTracer(ctx context.Context, t *tracer) context.Context {
+	return context.WithValue(ctx, tracerKey{}, t)
+}
+
+func tracerFromContext(ctx context.Context) *tracer {
+	t, _ := ctx.Value(tracerKey{}).(*tracer)
+
+	return t
+}
+
+func (t *tracer) record(e traceEvent) {
+	if t.keep {
+		e.Time = time.Now()
+		t.events = append(t.events, e)
+	}
+}
+
+func (t *tracer) commandStarted(e *event.CommandStartedEvent) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.commands[e.CommandName]++
+	t.record(traceEvent{
+		Type:     "command_started",
+		Name:     e.CommandName,
+		Database: e.DatabaseName,
+		Host:     hostFromConnectionID(e.ConnectionID),
+	})
+}
+
+func (t *tracer) commandSucceeded(e *event.CommandSucceededEvent) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.commandsDuration += e.Duration
+	t.record(traceEvent{
+		Type:       "command_succeeded",
+		Name:       e.CommandName,
+		Database:   e.DatabaseName,
+		Host:       hostFromConnectionID(e.ConnectionID),
+		DurationMs: e.Duration.Milliseconds(),
+	})
+}
+
+func (t *tracer) commandFailed(e *event.CommandFailedEvent) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.commandsDuration += e.Duration
+	t.commandFailures[e.CommandName]++
+	t.record(traceEvent{
+		Type:       "command_failed",
+		Name:       e.CommandName,
+		Database:   e.DatabaseName,
+		Host:       hostFromConnectionID(e.ConnectionID),
+		DurationMs: e.Duration.Milliseconds(),
+		Error:      e.Failure.Error(),
+	})
+}
+
+// poolMonitor counts connection pool events of the client.
+func (t *tracer) poolMonitor() *event.PoolMonitor {
+	return &event.PoolMonitor{
+		Event: func(e *event.PoolEvent) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+
+			switch e.Type {
+			case event.ConnectionCheckedOut:
+				t.poolCheckouts++
+			case event.ConnectionCheckOutFailed:
+				t.poolCheckoutFailures++
+			case event.ConnectionCreated:
+				t.connectionsCreated++
+			case event.ConnectionPoolCleared:
+				t.poolsCleared++
+			default:
+				return
+			}
+
+			te := traceEvent{
+				Type:       "pool",
+				Name:       e.Type,
+				Host:       e.Address,
+				DurationMs: e.Duration.Milliseconds(),
+			}
+
+			if e.Error != nil {
+				te.Error = e.Error.Error()
+			} else if e.Reason != "" {
+				te.Error = e.Reason
+			}
+
+			t.record(te)
+		},
+	}
+}
+
+// serverMonitor counts topology and server description changes and failed heartbeats of the client.
+func (t *tracer) serverMonitor() *event.ServerMonitor {
+	return &event.ServerMonitor{
+		TopologyDescriptionChanged: func(e *event.TopologyDescriptionChangedEvent) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+
+			t.topologyChanges++
+			t.record(traceEvent{
+				Type: "topology_changed",
+				Name: fmt.Sprintf("%s -> %s", e.PreviousDescription.Kind, e.NewDescription.Kind),
+			})
+		},
+		ServerDescriptionChanged: func(e *event.ServerDescriptionChangedEvent) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+
+			t.serverChanges++
+			t.record(traceEvent{
+				Type: "server_changed",
+				Name: fmt.Sprintf("%s -> %s", e.PreviousDescription.Kind, e.NewDescription.Kind),
+				Host: e.Address.String(),
+			})
+		},
+		ServerHeartbeatFailed: func(e *event.ServerHeartbeatFailedEvent) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+
+			t.heartbeatFailures++
+			t.record(traceEvent{
+				Type:       "heartbeat_failed",
+				Host:       hostFromConnectionID(e.ConnectionID),
+				DurationMs: e.Duration.Milliseconds(),
+				Error:      e.Failure.Error(),
+			})
+		},
+	}
+}
+
+func (t *tracer) summary() traceSummary {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	commands := make(map[string]int, len(t.commands))
+	for k, v := range t.commands {
+		commands[k] = v
+	}
+
+	failures := make(map[string]int, len(t.commandFailures))
+	for k, v := range t.commandFailures {
+		failures[k] = v
+	}
+
+	return traceSummary{
+		Commands:             commands,
+		CommandFailures:      failures,
+		CommandsDurationMs:   t.commandsDuration.Milliseconds(),
+		PoolCheckouts:        t.poolCheckouts,
+		PoolCheckoutFailures: t.poolCheckoutFailures,
+		ConnectionsCreated:   t.connectionsCreated,
+		PoolsCleared:         t.poolsCleared,
+		TopologyChanges:      t.topologyChanges,
+		ServerChanges:        t.serverChanges,
+		HeartbeatFailures:    t.heartbeatFailures,
+	}
+}
+
+// finish logs the summary of the operation and appends it to the trace file, if configured.
+func (t *tracer) finish(ctx context.Context, traceFile string, attempts uint, opErr error) {
+	s := t.summary()
+	duration := time.Since(t.start)
+
+	tflog.SubsystemDebug(ctx, logSubsystem, "MongoDB operation summary", map[string]interface{}{
+		"duration_ms":            duration.Milliseconds(),
+		"attempts":               attempts,
+		"commands":               s.Commands,
+		"command_failures":       s.CommandFailures,
+		"commands_duration_ms":   s.CommandsDurationMs,
+		"pool_checkouts":         s.PoolCheckouts,
+		"pool_checkout_failures": s.PoolCheckoutFailures,
+		"connections_created":    s.ConnectionsCreated,
+		"pools_cleared":          s.PoolsCleared,
+		"topology_changes":       s.TopologyChanges,
+		"server_changes":         s.ServerChanges,
+		"heartbeat_failures":     s.HeartbeatFailures,
+	})
+
+	if traceFile == "" {
+		return
+	}
+
+	t.mu.Lock()
+	record := traceRecord{
+		Operation:  t.operation,
+		Start:      t.start,
+		DurationMs: duration.Milliseconds(),
+		Attempts:   attempts,
+		Summary:    s,
+		Events:     t.events,
+	}
+	t.mu.Unlock()
+
+	if opErr != nil {
+		record.Error = opErr.Error()
+	}
+
+	if err := appendTraceRecord(traceFile, record); err != nil {
+		tflog.SubsystemWarn(ctx, logSubsystem, "Failed to write MongoDB trace file", map[string]interface{}{
+			"trace_file": traceFile,
+			"error":      err.Error(),
+		})
+	}
+}
+
+// appendTraceRecord writes the record as a single JSON line to the end of the trace file.
+func appendTraceRecord(traceFile string, record traceRecord) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	traceFileMutex.Lock()
+	defer traceFileMutex.Unlock()
+
+	f, err := os.OpenFile(traceFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(append(b, '\n'))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
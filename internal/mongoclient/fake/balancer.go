@@ -0,0 +1,125 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+type resourceBalancer struct {
+	c *Client
+}
+
+// SetBalancer replaces the balancer configuration in the model, bypassing the hooks. Use it to simulate drift.
+func (c *Client) SetBalancer(b types.Balancer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.balancer = balancerSettings(b)
+}
+
+// GetBalancer returns the balancer configuration stored in the model.
+func (c *Client) GetBalancer() types.Balancer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return balancerSettings(c.balancer)
+}
+
+func (r *resourceBalancer) Create(ctx context.Context, plan types.Balancer) error {
+	if err := r.c.before(ctx, MethodResourceBalancerCreate); err != nil {
+		return err
+	}
+
+	return r.c.applyBalancer(plan)
+}
+
+func (r *resourceBalancer) Update(ctx context.Context, plan types.Balancer) error {
+	if err := r.c.before(ctx, MethodResourceBalancerUpdate); err != nil {
+		return err
+	}
+
+	return r.c.applyBalancer(plan)
+}
+
+// Delete restores the defaults, like the mongodb package.
+func (r *resourceBalancer) Delete(ctx context.Context, _ types.Balancer) error {
+	if err := r.c.before(ctx, MethodResourceBalancerDelete); err != nil {
+		return err
+	}
+
+	return r.c.applyBalancer(types.Balancer{Enabled: true})
+}
+
+func (r *resourceBalancer) Read(ctx context.Context, state types.Balancer) (types.Balancer, error) {
+	if err := r.c.before(ctx, MethodResourceBalancerRead); err != nil {
+		return state, err
+	}
+
+	b := r.c.GetBalancer()
+
+	if len(b.DisabledCollections) == 0 && state.DisabledCollections == nil {
+		b.DisabledCollections = nil
+	}
+
+	b.Retry = state.Retry
+	b.Timeouts = state.Timeouts
+
+	return b, nil
+}
+
+func (r *resourceBalancer) ImportState(ctx context.Context) (types.Balancer, error) {
+	if err := r.c.before(ctx, MethodResourceBalancerImportState); err != nil {
+		return types.Balancer{}, err
+	}
+
+	b := r.c.GetBalancer()
+
+	if len(b.DisabledCollections) == 0 {
+		b.DisabledCollections = nil
+	}
+
+	return b, nil
+}
+
+// applyBalancer requires the collections with disabled balancing to be sharded, like the mongodb package.
+func (c *Client) applyBalancer(b types.Balancer) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, ns := range b.DisabledCollections {
+		if _, ok := c.collections[ns]; !ok {
+			return fmt.Errorf("collection %s is not sharded", ns)
+		}
+	}
+
+	c.balancer = balancerSettings(b)
+
+	return nil
+}
+
+// balancerSettings returns a copy of the balancer configuration without the resource-only attributes.
+func balancerSettings(b types.Balancer) types.Balancer {
+	s := types.Balancer{
+		Enabled:             b.Enabled,
+		DisabledCollections: slices.Clone(b.DisabledCollections),
+	}
+
+	if b.ActiveWindow != nil {
+		window := *b.ActiveWindow
+		s.ActiveWindow = &window
+	}
+
+	if b.ChunkSizeMB != nil {
+		size := *b.ChunkSizeMB
+		s.ChunkSizeMB = &size
+	}
+
+	if s.DisabledCollections == nil {
+		s.DisabledCollections = []string{}
+	}
+
+	return s
+}
@@ -0,0 +1,101 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+type resourceClusterParameter struct {
+	c *Client
+}
+
+// SetClusterParameter sets the cluster parameter in the model, bypassing the hooks. Parameters must be set
+// before they are managed, like the parameters known to the cluster.
+func (c *Client) SetClusterParameter(name, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.clusterParameters[name] = value
+}
+
+// GetClusterParameter returns the cluster parameter stored in the model.
+func (c *Client) GetClusterParameter(name string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	value, ok := c.clusterParameters[name]
+
+	return value, ok
+}
+
+func (r *resourceClusterParameter) Create(ctx context.Context, plan types.ClusterParameter) (types.ClusterParameter, error) {
+	if err := r.c.before(ctx, MethodResourceClusterParameterCreate); err != nil {
+		return plan, err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	previous, ok := r.c.clusterParameters[plan.Name]
+	if !ok {
+		return plan, fmt.Errorf("cluster parameter %s not found", plan.Name)
+	}
+
+	plan.PreviousValue = &previous
+	r.c.clusterParameters[plan.Name] = plan.Value
+
+	return plan, nil
+}
+
+func (r *resourceClusterParameter) Update(ctx context.Context, plan types.ClusterParameter) error {
+	if err := r.c.before(ctx, MethodResourceClusterParameterUpdate); err != nil {
+		return err
+	}
+
+	r.c.SetClusterParameter(plan.Name, plan.Value)
+
+	return nil
+}
+
+func (r *resourceClusterParameter) Delete(ctx context.Context, state types.ClusterParameter) error {
+	if err := r.c.before(ctx, MethodResourceClusterParameterDelete); err != nil {
+		return err
+	}
+
+	if state.PreviousValue != nil {
+		r.c.SetClusterParameter(state.Name, *state.PreviousValue)
+	}
+
+	return nil
+}
+
+// Read returns the current value when it differs from the state. Unlike the mongodb package, values are compared as strings.
+func (r *resourceClusterParameter) Read(ctx context.Context, state types.ClusterParameter) (types.ClusterParameter, error) {
+	if err := r.c.before(ctx, MethodResourceClusterParameterRead); err != nil {
+		return state, err
+	}
+
+	current, ok := r.c.GetClusterParameter(state.Name)
+	if !ok {
+		return state, fmt.Errorf("cluster parameter %s not found", state.Name)
+	}
+
+	state.Value = current
+
+	return state, nil
+}
+
+func (r *resourceClusterParameter) ImportState(ctx context.Context, name string) (types.ClusterParameter, error) {
+	if err := r.c.before(ctx, MethodResourceClusterParameterImportState); err != nil {
+		return types.ClusterParameter{}, err
+	}
+
+	current, ok := r.c.GetClusterParameter(name)
+	if !ok {
+		return types.ClusterParameter{}, fmt.Errorf("cluster parameter %s not found", name)
+	}
+
+	return types.ClusterParameter{Name: name, Value: current, PreviousValue: &current}, nil
+}
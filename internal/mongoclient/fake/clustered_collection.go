@@ -0,0 +1,132 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+type resourceClusteredCollection struct {
+	c *Client
+}
+
+// SetClusteredCollection stores the collection in the model, bypassing the hooks. Use it to simulate drift.
+func (c *Client) SetClusteredCollection(cc types.ClusteredCollection) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.clustered[cc.Namespace()] = clusteredDefinition(cc)
+}
+
+// RemoveClusteredCollection drops the collection from the model, bypassing the hooks. Use it to simulate drift.
+func (c *Client) RemoveClusteredCollection(namespace string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.clustered, namespace)
+}
+
+// GetClusteredCollection returns the collection stored in the model.
+func (c *Client) GetClusteredCollection(namespace string) (types.ClusteredCollection, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cc, ok := c.clustered[namespace]
+
+	return clusteredDefinition(cc), ok
+}
+
+// Create stores the collection and its database, like MongoDB creates the database of a new collection.
+func (r *resourceClusteredCollection) Create(ctx context.Context, plan types.ClusteredCollection) error {
+	if err := r.c.before(ctx, MethodResourceClusteredCollectionCreate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	if _, ok := r.c.clustered[plan.Namespace()]; ok {
+		return fmt.Errorf("clustered collection %s already exists", plan.Namespace())
+	}
+
+	r.c.clustered[plan.Namespace()] = clusteredDefinition(plan)
+	r.c.databases[plan.Database] = struct{}{}
+
+	return nil
+}
+
+// Update replaces the expiration of the collection, keeping its index like collMod.
+func (r *resourceClusteredCollection) Update(ctx context.Context, plan types.ClusteredCollection) error {
+	if err := r.c.before(ctx, MethodResourceClusteredCollectionUpdate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	current, ok := r.c.clustered[plan.Namespace()]
+	if !ok {
+		return fmt.Errorf("clustered collection %s does not exist", plan.Namespace())
+	}
+
+	current.ExpireAfterSeconds = copyPtr(plan.ExpireAfterSeconds)
+	r.c.clustered[plan.Namespace()] = current
+
+	return nil
+}
+
+func (r *resourceClusteredCollection) Delete(ctx context.Context, state types.ClusteredCollection) error {
+	if err := r.c.before(ctx, MethodResourceClusteredCollectionDelete); err != nil {
+		return err
+	}
+
+	r.c.RemoveClusteredCollection(state.Namespace())
+
+	return nil
+}
+
+// Read returns the stored collection. Unlike the mongodb package, the fake does not report the default index name.
+func (r *resourceClusteredCollection) Read(ctx context.Context, state types.ClusteredCollection) (types.ClusteredCollection, bool, error) {
+	if err := r.c.before(ctx, MethodResourceClusteredCollectionRead); err != nil {
+		return state, false, err
+	}
+
+	current, ok := r.c.GetClusteredCollection(state.Namespace())
+	if !ok {
+		return state, false, nil
+	}
+
+	state.IndexName = current.IndexName
+	state.ExpireAfterSeconds = current.ExpireAfterSeconds
+
+	return state, true, nil
+}
+
+func (r *resourceClusteredCollection) ImportState(ctx context.Context, namespace string) (types.ClusteredCollection, error) {
+	if err := r.c.before(ctx, MethodResourceClusteredCollectionImportState); err != nil {
+		return types.ClusteredCollection{}, err
+	}
+
+	if !strings.Contains(namespace, ".") {
+		return types.ClusteredCollection{}, fmt.Errorf("invalid import ID %q, expected <database>.<collection>", namespace)
+	}
+
+	current, ok := r.c.GetClusteredCollection(namespace)
+	if !ok {
+		return types.ClusteredCollection{}, fmt.Errorf("clustered collection %s does not exist", namespace)
+	}
+
+	return current, nil
+}
+
+// clusteredDefinition returns a copy of the collection without the resource-only attributes.
+func clusteredDefinition(cc types.ClusteredCollection) types.ClusteredCollection {
+	return types.ClusteredCollection{
+		Database:           cc.Database,
+		Name:               cc.Name,
+		IndexName:          copyPtr(cc.IndexName),
+		ExpireAfterSeconds: copyPtr(cc.ExpireAfterSeconds),
+	}
+}
@@ -0,0 +1,178 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+type dataSourceCollection struct {
+	c *Client
+}
+
+type dataSourceIndex struct {
+	c *Client
+}
+
+// SetCollectionStats stores the statistics of a collection in the model, bypassing the hooks.
+func (c *Client) SetCollectionStats(namespace string, stats types.CollectionStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.collStats[namespace] = stats
+}
+
+// SetIndexes stores the indexes of a collection in the model, bypassing the hooks. The collection of the indexes
+// is set from the namespace.
+func (c *Client) SetIndexes(namespace string, indexes []types.Index) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, collection, _ := strings.Cut(namespace, ".")
+
+	c.indexes[namespace] = nil
+	for _, index := range indexes {
+		index.Collection = collection
+		c.indexes[namespace] = append(c.indexes[namespace], index)
+	}
+}
+
+// collectionTypes returns the types of the collections, views, time-series and clustered collections
+// of the database by name. It must be called with the lock held.
+func (c *Client) collectionTypes(database string) map[string]string {
+	collections := map[string]string{}
+
+	for name := range c.dbCollections[database] {
+		collections[name] = "collection"
+	}
+
+	for _, v := range c.views {
+		if v.Database == database {
+			collections[v.Name] = "view"
+		}
+	}
+
+	for _, ts := range c.timeSeries {
+		if ts.Database == database {
+			collections[ts.Name] = "timeseries"
+		}
+	}
+
+	for _, cc := range c.clustered {
+		if cc.Database == database {
+			collections[cc.Name] = "collection"
+		}
+	}
+
+	for namespace := range c.indexes {
+		if db, name, _ := strings.Cut(namespace, "."); db == database {
+			if _, ok := collections[name]; !ok {
+				collections[name] = "collection"
+			}
+		}
+	}
+
+	return collections
+}
+
+// compileNameRegex compiles the optional name regex of a data source. The mongodb package leaves it to MongoDB,
+// the Go syntax covers the expressions the tests use.
+func compileNameRegex(regex *string) (*regexp.Regexp, error) {
+	if regex == nil {
+		return nil, nil
+	}
+
+	re, err := regexp.Compile(*regex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid name regex: %w", err)
+	}
+
+	return re, nil
+}
+
+// Read lists the collections of the database like the mongodb package, the name regex uses the Go syntax.
+// The fake does not model the options and validators, the options are always empty.
+func (d *dataSourceCollection) Read(ctx context.Context, config types.Collections) (types.Collections, error) {
+	if err := d.c.before(ctx, MethodDataSourceCollectionRead); err != nil {
+		return config, err
+	}
+
+	re, err := compileNameRegex(config.NameRegex)
+	if err != nil {
+		return config, err
+	}
+
+	d.c.mu.Lock()
+	defer d.c.mu.Unlock()
+
+	config.Collections = []types.Collection{}
+
+	for name, collectionType := range d.c.collectionTypes(config.Database) {
+		if re != nil && !re.MatchString(name) {
+			continue
+		}
+
+		collection := types.Collection{Name: name, Type: collectionType, Options: "{}"}
+
+		if config.WithStatistics() && collectionType != "view" {
+			stats := d.c.collStats[config.Database+"."+name]
+			collection.Stats = &stats
+		}
+
+		config.Collections = append(config.Collections, collection)
+	}
+
+	sort.Slice(config.Collections, func(i, j int) bool {
+		return config.Collections[i].Name < config.Collections[j].Name
+	})
+
+	return config, nil
+}
+
+// Read lists the stored indexes of the collection or of all collections of the database. The name regex
+// uses the Go syntax, which covers the common subset of the PCRE syntax MongoDB evaluates.
+func (d *dataSourceIndex) Read(ctx context.Context, config types.Indexes) (types.Indexes, error) {
+	if err := d.c.before(ctx, MethodDataSourceIndexRead); err != nil {
+		return config, err
+	}
+
+	re, err := compileNameRegex(config.NameRegex)
+	if err != nil {
+		return config, err
+	}
+
+	d.c.mu.Lock()
+	defer d.c.mu.Unlock()
+
+	collectionTypes := d.c.collectionTypes(config.Database)
+
+	var collections []string
+
+	if config.Collection != nil {
+		collections = []string{*config.Collection}
+	} else {
+		for name, collectionType := range collectionTypes {
+			if collectionType != "view" {
+				collections = append(collections, name)
+			}
+		}
+
+		sort.Strings(collections)
+	}
+
+	config.Indexes = []types.Index{}
+
+	for _, collection := range collections {
+		for _, index := range d.c.indexes[config.Database+"."+collection] {
+			if re == nil || re.MatchString(index.Name) {
+				config.Indexes = append(config.Indexes, index)
+			}
+		}
+	}
+
+	return config, nil
+}
@@ -0,0 +1,275 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+type dataSourceDatabase struct {
+	c *Client
+}
+
+type resourceDatabase struct {
+	c *Client
+}
+
+// AddDatabase creates a database in the model, bypassing the hooks.
+func (c *Client) AddDatabase(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.databases[name] = struct{}{}
+}
+
+// RemoveDatabase drops a database from the model, bypassing the hooks. Use it to simulate drift.
+func (c *Client) RemoveDatabase(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.databases, name)
+	delete(c.dbCollections, name)
+	delete(c.dbStats, name)
+}
+
+// SetDatabaseStats stores the statistics of a database in the model, bypassing the hooks.
+// Without them, a database reports only the number of its collections.
+func (c *Client) SetDatabaseStats(name string, stats types.DatabaseStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.dbStats[name] = stats
+}
+
+// AddCollection creates a collection and its database in the model, bypassing the hooks.
+func (c *Client) AddCollection(database, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.addCollection(database, name)
+}
+
+// DatabaseCollections returns the sorted names of the collections of the database stored in the model.
+func (c *Client) DatabaseCollections(database string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	names := make([]string, 0, len(c.dbCollections[database]))
+	for name := range c.dbCollections[database] {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// addCollection must be called with the lock held.
+func (c *Client) addCollection(database, name string) {
+	if c.dbCollections[database] == nil {
+		c.dbCollections[database] = map[string]struct{}{}
+	}
+
+	c.databases[database] = struct{}{}
+	c.dbCollections[database][name] = struct{}{}
+}
+
+// markerRemovable reports whether the marker collection exists next to another collection. It must be called with the lock held.
+func (c *Client) markerRemovable(d types.Database) bool {
+	collections := c.dbCollections[d.Name]
+	_, ok := collections[d.Marker()]
+
+	return ok && len(collections) > 1
+}
+
+// removeMarker drops the marker collection if the database has another collection. It must be called with the lock held.
+func (c *Client) removeMarker(d types.Database) {
+	if c.markerRemovable(d) {
+		delete(c.dbCollections[d.Name], d.Marker())
+	}
+}
+
+// HasDatabase reports whether the database exists in the model.
+func (c *Client) HasDatabase(name string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, ok := c.databases[name]
+
+	return ok
+}
+
+// Read lists the databases like the mongodb package, the name regex uses the Go syntax.
+func (d *dataSourceDatabase) Read(ctx context.Context, config types.DataSourceDatabases) (types.DataSourceDatabases, error) {
+	if err := d.c.before(ctx, MethodDataSourceDatabaseRead); err != nil {
+		return config, err
+	}
+
+	re, err := compileNameRegex(config.NameRegex)
+	if err != nil {
+		return config, err
+	}
+
+	d.c.mu.Lock()
+	defer d.c.mu.Unlock()
+
+	names := make([]string, 0, len(d.c.databases))
+	for name := range d.c.databases {
+		if slices.Contains(types.DefaultDatabases, name) || re != nil && !re.MatchString(name) {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	if len(names) == 0 {
+		if config.NameRegex != nil {
+			return config, fmt.Errorf("databases matching %q not found", *config.NameRegex)
+		}
+
+		return config, fmt.Errorf("databases not found. You can create a database using resource mongodb_database")
+	}
+
+	sort.Strings(names)
+
+	config.Databases = nil
+	for _, name := range names {
+		database := types.DataSourceDatabase{Name: name}
+
+		if config.WithStatistics() {
+			stats, ok := d.c.dbStats[name]
+			if !ok {
+				stats.Collections = int64(len(d.c.dbCollections[name]))
+				stats.Empty = stats.Collections == 0
+			}
+
+			database.Stats = &stats
+		}
+
+		config.Databases = append(config.Databases, database)
+	}
+
+	return config, nil
+}
+
+func (r *resourceDatabase) Create(ctx context.Context, plan types.Database) error {
+	if slices.Contains(types.DefaultDatabases, plan.Name) {
+		return fmt.Errorf("database %s is a default database and cannot be created", plan.Name)
+	}
+
+	if err := r.c.before(ctx, MethodResourceDatabaseCreate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	if _, ok := r.c.databases[plan.Name]; ok {
+		return fmt.Errorf("database %s already exists", plan.Name)
+	}
+
+	if plan.Mode() == types.DatabaseMarkerModeNone && len(plan.Collections) == 0 {
+		return fmt.Errorf("database %s needs collections without a marker collection", plan.Name)
+	}
+
+	for _, name := range plan.Collections {
+		r.c.addCollection(plan.Name, name)
+	}
+
+	if plan.NeedsMarker() {
+		r.c.addCollection(plan.Name, plan.Marker())
+	}
+
+	return nil
+}
+
+// Update creates the missing collections and drops the marker in the remove mode, like the mongodb package.
+func (r *resourceDatabase) Update(ctx context.Context, plan types.Database) error {
+	if err := r.c.before(ctx, MethodResourceDatabaseUpdate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	for _, name := range plan.Collections {
+		r.c.addCollection(plan.Name, name)
+	}
+
+	if plan.Mode() == types.DatabaseMarkerModeRemove {
+		r.c.removeMarker(plan)
+	}
+
+	return nil
+}
+
+// Delete drops the database. The model has no documents, so with drop_only_if_empty any collection
+// other than the marker is treated as holding data.
+func (r *resourceDatabase) Delete(ctx context.Context, state types.Database) error {
+	if err := r.c.before(ctx, MethodResourceDatabaseDelete); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	if _, ok := r.c.databases[state.Name]; !ok {
+		return fmt.Errorf("database %s does not exist", state.Name)
+	}
+
+	if state.IsDropOnlyIfEmpty() {
+		var others []string
+		for name := range r.c.dbCollections[state.Name] {
+			if name != state.Marker() {
+				others = append(others, name)
+			}
+		}
+
+		if len(others) > 0 {
+			sort.Strings(others)
+
+			return fmt.Errorf("database %s holds the collections %s and drop_only_if_empty is set", state.Name, strings.Join(others, ", "))
+		}
+	}
+
+	delete(r.c.databases, state.Name)
+	delete(r.c.dbCollections, state.Name)
+
+	return nil
+}
+
+// Read sets marker_present like the mongodb package.
+func (r *resourceDatabase) Read(ctx context.Context, state types.Database) (types.Database, bool, error) {
+	if err := r.c.before(ctx, MethodResourceDatabaseRead); err != nil {
+		return state, false, err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	_, ok := r.c.databases[state.Name]
+	present := ok && state.Mode() == types.DatabaseMarkerModeRemove && r.c.markerRemovable(state)
+	state.MarkerPresent = &present
+
+	return state, ok, nil
+}
+
+func (r *resourceDatabase) ImportState(ctx context.Context, name string) (types.Database, error) {
+	if slices.Contains(types.DefaultDatabases, name) {
+		return types.Database{}, fmt.Errorf("database %s is a default database and cannot be imported", name)
+	}
+
+	if err := r.c.before(ctx, MethodResourceDatabaseImportState); err != nil {
+		return types.Database{}, err
+	}
+
+	if !r.c.HasDatabase(name) {
+		return types.Database{}, fmt.Errorf("database %s does not exist", name)
+	}
+
+	return types.Database{Name: name}, nil
+}
@@ -0,0 +1,107 @@
+package fake
+
+import (
+	"context"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+type resourceDefaultRWConcern struct {
+	c *Client
+}
+
+// SetDefaultRWConcern replaces the default read/write concern in the model, bypassing the hooks. Use it to simulate drift.
+func (c *Client) SetDefaultRWConcern(d types.DefaultRWConcern) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rwConcern = rwConcernDefaults(d)
+}
+
+// GetDefaultRWConcern returns the default read/write concern stored in the model.
+func (c *Client) GetDefaultRWConcern() types.DefaultRWConcern {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return rwConcernDefaults(c.rwConcern)
+}
+
+func (r *resourceDefaultRWConcern) Create(ctx context.Context, plan types.DefaultRWConcern) error {
+	if err := r.c.before(ctx, MethodResourceDefaultRWConcernCreate); err != nil {
+		return err
+	}
+
+	r.c.SetDefaultRWConcern(plan)
+
+	return nil
+}
+
+func (r *resourceDefaultRWConcern) Update(ctx context.Context, plan types.DefaultRWConcern) error {
+	if err := r.c.before(ctx, MethodResourceDefaultRWConcernUpdate); err != nil {
+		return err
+	}
+
+	r.c.SetDefaultRWConcern(plan)
+
+	return nil
+}
+
+// Delete resets the write concern to majority and unsets the read concern, like the mongodb package.
+func (r *resourceDefaultRWConcern) Delete(ctx context.Context, _ types.DefaultRWConcern) error {
+	if err := r.c.before(ctx, MethodResourceDefaultRWConcernDelete); err != nil {
+		return err
+	}
+
+	r.c.SetDefaultRWConcern(types.DefaultRWConcern{WriteConcern: types.MajorityWriteConcern()})
+
+	return nil
+}
+
+func (r *resourceDefaultRWConcern) Read(ctx context.Context, state types.DefaultRWConcern) (types.DefaultRWConcern, error) {
+	if err := r.c.before(ctx, MethodResourceDefaultRWConcernRead); err != nil {
+		return state, err
+	}
+
+	d := r.c.GetDefaultRWConcern()
+	d.Retry = state.Retry
+	d.Timeouts = state.Timeouts
+
+	return d, nil
+}
+
+func (r *resourceDefaultRWConcern) ImportState(ctx context.Context) (types.DefaultRWConcern, error) {
+	if err := r.c.before(ctx, MethodResourceDefaultRWConcernImportState); err != nil {
+		return types.DefaultRWConcern{}, err
+	}
+
+	return r.c.GetDefaultRWConcern(), nil
+}
+
+// rwConcernDefaults returns a copy of the defaults without the resource-only attributes.
+func rwConcernDefaults(d types.DefaultRWConcern) types.DefaultRWConcern {
+	wc := types.WriteConcern{}
+
+	if d.WriteConcern.W != nil {
+		w := *d.WriteConcern.W
+		wc.W = &w
+	}
+
+	if d.WriteConcern.J != nil {
+		j := *d.WriteConcern.J
+		wc.J = &j
+	}
+
+	if d.WriteConcern.WTimeout != nil {
+		wtimeout := *d.WriteConcern.WTimeout
+		wc.WTimeout = &wtimeout
+	}
+
+	s := types.DefaultRWConcern{WriteConcern: wc}
+
+	if d.ReadConcern != nil {
+		rc := *d.ReadConcern
+		s.ReadConcern = &rc
+	}
+
+	return s
+}
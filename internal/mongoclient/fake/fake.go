@@ -0,0 +1,352 @@
+// Package fake provides an in-memory implementation of interfaces.Client for unit tests.
+//
+// The fake keeps a model of the cluster, such as databases, users, shards and parameters, and follows
+// the behaviour of the mongodb package for it. Every method calls Hook first, so tests can inject errors
+// or latency for a single method.
+package fake
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"terraform-provider-mongodb/internal/mongoclient/interfaces"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+var _ interfaces.Client = &Client{}
+
+// Method names passed to Hook and used for error injection.
+const (
+	MethodDataSourceDatabaseRead   = "DataSource.Database.Read"
+	MethodDataSourceUserRead       = "DataSource.User.Read"
+	MethodDataSourceReplicaSetRead = "DataSource.ReplicaSet.Read"
+	MethodDataSourceShardingRead   = "DataSource.Sharding.Read"
+	MethodDataSourceCollectionRead = "DataSource.Collection.Read"
+	MethodDataSourceIndexRead      = "DataSource.Index.Read"
+
+	MethodResourceDatabaseCreate      = "Resource.Database.Create"
+	MethodResourceDatabaseUpdate      = "Resource.Database.Update"
+	MethodResourceDatabaseDelete      = "Resource.Database.Delete"
+	MethodResourceDatabaseRead        = "Resource.Database.Read"
+	MethodResourceDatabaseImportState = "Resource.Database.ImportState"
+
+	MethodResourceUserCreate      = "Resource.User.Create"
+	MethodResourceUserDelete      = "Resource.User.Delete"
+	MethodResourceUserUpdate      = "Resource.User.Update"
+	MethodResourceUserExists      = "Resource.User.Exists"
+	MethodResourceUserImportState = "Resource.User.ImportState"
+
+	MethodResourceReplicaSetCreate      = "Resource.ReplicaSet.Create"
+	MethodResourceReplicaSetUpdate      = "Resource.ReplicaSet.Update"
+	MethodResourceReplicaSetExists      = "Resource.ReplicaSet.Exists"
+	MethodResourceReplicaSetImportState = "Resource.ReplicaSet.ImportState"
+
+	MethodResourceShardCreate      = "Resource.Shard.Create"
+	MethodResourceShardDelete      = "Resource.Shard.Delete"
+	MethodResourceShardRead        = "Resource.Shard.Read"
+	MethodResourceShardImportState = "Resource.Shard.ImportState"
+
+	MethodResourceShardedCollectionCreate      = "Resource.ShardedCollection.Create"
+	MethodResourceShardedCollectionUpdate      = "Resource.ShardedCollection.Update"
+	MethodResourceShardedCollectionRead        = "Resource.ShardedCollection.Read"
+	MethodResourceShardedCollectionImportState = "Resource.ShardedCollection.ImportState"
+
+	MethodResourceShardZoneCreate      = "Resource.ShardZone.Create"
+	MethodResourceShardZoneDelete      = "Resource.ShardZone.Delete"
+	MethodResourceShardZoneExists      = "Resource.ShardZone.Exists"
+	MethodResourceShardZoneImportState = "Resource.ShardZone.ImportState"
+
+	MethodResourceZoneKeyRangeCreate      = "Resource.ZoneKeyRange.Create"
+	MethodResourceZoneKeyRangeDelete      = "Resource.ZoneKeyRange.Delete"
+	MethodResourceZoneKeyRangeRead        = "Resource.ZoneKeyRange.Read"
+	MethodResourceZoneKeyRangeImportState = "Resource.ZoneKeyRange.ImportState"
+
+	MethodResourceBalancerCreate      = "Resource.Balancer.Create"
+	MethodResourceBalancerUpdate      = "Resource.Balancer.Update"
+	MethodResourceBalancerDelete      = "Resource.Balancer.Delete"
+	MethodResourceBalancerRead        = "Resource.Balancer.Read"
+	MethodResourceBalancerImportState = "Resource.Balancer.ImportState"
+
+	MethodResourceDefaultRWConcernCreate      = "Resource.DefaultRWConcern.Create"
+	MethodResourceDefaultRWConcernUpdate      = "Resource.DefaultRWConcern.Update"
+	MethodResourceDefaultRWConcernDelete      = "Resource.DefaultRWConcern.Delete"
+	MethodResourceDefaultRWConcernRead        = "Resource.DefaultRWConcern.Read"
+	MethodResourceDefaultRWConcernImportState = "Resource.DefaultRWConcern.ImportState"
+
+	MethodResourceServerParameterCreate      = "Resource.ServerParameter.Create"
+	MethodResourceServerParameterUpdate      = "Resource.ServerParameter.Update"
+	MethodResourceServerParameterDelete      = "Resource.ServerParameter.Delete"
+	MethodResourceServerParameterRead        = "Resource.ServerParameter.Read"
+	MethodResourceServerParameterImportState = "Resource.ServerParameter.ImportState"
+
+	MethodResourceClusterParameterCreate      = "Resource.ClusterParameter.Create"
+	MethodResourceClusterParameterUpdate      = "Resource.ClusterParameter.Update"
+	MethodResourceClusterParameterDelete      = "Resource.ClusterParameter.Delete"
+	MethodResourceClusterParameterRead        = "Resource.ClusterParameter.Read"
+	MethodResourceClusterParameterImportState = "Resource.ClusterParameter.ImportState"
+
+	MethodResourceFeatureCompatibilityVersionCreate      = "Resource.FeatureCompatibilityVersion.Create"
+	MethodResourceFeatureCompatibilityVersionUpdate      = "Resource.FeatureCompatibilityVersion.Update"
+	MethodResourceFeatureCompatibilityVersionDelete      = "Resource.FeatureCompatibilityVersion.Delete"
+	MethodResourceFeatureCompatibilityVersionRead        = "Resource.FeatureCompatibilityVersion.Read"
+	MethodResourceFeatureCompatibilityVersionImportState = "Resource.FeatureCompatibilityVersion.ImportState"
+
+	MethodResourceProfilerCreate      = "Resource.Profiler.Create"
+	MethodResourceProfilerUpdate      = "Resource.Profiler.Update"
+	MethodResourceProfilerDelete      = "Resource.Profiler.Delete"
+	MethodResourceProfilerRead        = "Resource.Profiler.Read"
+	MethodResourceProfilerImportState = "Resource.Profiler.ImportState"
+
+	MethodResourceViewCreate      = "Resource.View.Create"
+	MethodResourceViewUpdate      = "Resource.View.Update"
+	MethodResourceViewDelete      = "Resource.View.Delete"
+	MethodResourceViewRead        = "Resource.View.Read"
+	MethodResourceViewImportState = "Resource.View.ImportState"
+
+	MethodResourceTimeSeriesCollectionCreate      = "Resource.TimeSeriesCollection.Create"
+	MethodResourceTimeSeriesCollectionUpdate      = "Resource.TimeSeriesCollection.Update"
+	MethodResourceTimeSeriesCollectionDelete      = "Resource.TimeSeriesCollection.Delete"
+	MethodResourceTimeSeriesCollectionRead        = "Resource.TimeSeriesCollection.Read"
+	MethodResourceTimeSeriesCollectionImportState = "Resource.TimeSeriesCollection.ImportState"
+
+	MethodResourceClusteredCollectionCreate      = "Resource.ClusteredCollection.Create"
+	MethodResourceClusteredCollectionUpdate      = "Resource.ClusteredCollection.Update"
+	MethodResourceClusteredCollectionDelete      = "Resource.ClusteredCollection.Delete"
+	MethodResourceClusteredCollectionRead        = "Resource.ClusteredCollection.Read"
+	MethodResourceClusteredCollectionImportState = "Resource.ClusteredCollection.ImportState"
+)
+
+// Client is an in-memory MongoDB. The zero value is not usable, use New.
+type Client struct {
+	mu sync.Mutex
+
+	databases         map[string]struct{}
+	dbCollections     map[string]map[string]struct{}
+	dbStats           map[string]types.DatabaseStats
+	users             map[string]types.User
+	replicaSet        *types.ReplicaSet
+	shards            map[string]string
+	collections       map[string]types.ShardedCollection
+	zones             map[string][]string
+	zoneRanges        []types.ZoneKeyRange
+	balancer          types.Balancer
+	primaries         map[string]string
+	chunks            map[string]map[string]int64
+	rwConcern         types.DefaultRWConcern
+	parameters        map[string]map[string]string
+	clusterParameters map[string]string
+	fcv               string
+	profilers         map[string]types.Profiler
+	views             map[string]types.View
+	timeSeries        map[string]types.TimeSeriesCollection
+	clustered         map[string]types.ClusteredCollection
+	collStats         map[string]types.CollectionStats
+	indexes           map[string][]types.Index
+
+	errors  map[string]error
+	latency time.Duration
+	calls   map[string]int
+
+	// Hook is called before every method with its name. A non-nil error is returned by the method
+	// without touching the model. It is called after the injected errors and latency.
+	Hook func(ctx context.Context, method string) error
+}
+
+// New returns an empty fake client.
+func New() *Client {
+	return &Client{
+		databases:         map[string]struct{}{},
+		dbCollections:     map[string]map[string]struct{}{},
+		dbStats:           map[string]types.DatabaseStats{},
+		users:             map[string]types.User{},
+		shards:            map[string]string{},
+		collections:       map[string]types.ShardedCollection{},
+		zones:             map[string][]string{},
+		balancer:          types.Balancer{Enabled: true},
+		primaries:         map[string]string{},
+		chunks:            map[string]map[string]int64{},
+		rwConcern:         types.DefaultRWConcern{WriteConcern: types.MajorityWriteConcern()},
+		parameters:        map[string]map[string]string{},
+		clusterParameters: map[string]string{},
+		fcv:               DefaultFeatureCompatibilityVersion,
+		profilers:         map[string]types.Profiler{},
+		views:             map[string]types.View{},
+		timeSeries:        map[string]types.TimeSeriesCollection{},
+		clustered:         map[string]types.ClusteredCollection{},
+		collStats:         map[string]types.CollectionStats{},
+		indexes:           map[string][]types.Index{},
+		errors:            map[string]error{},
+		calls:             map[string]int{},
+	}
+}
+
+func (c *Client) DataSource() interfaces.DataSource {
+	return &dataSource{c: c}
+}
+
+func (c *Client) Resource() interfaces.Resource {
+	return &resource{c: c}
+}
+
+/* ERROR INJECTION AND LATENCY */
+
+// InjectError makes every call of method fail with err until it is cleared with a nil error.
+func (c *Client) InjectError(method string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err == nil {
+		delete(c.errors, method)
+		return
+	}
+
+	c.errors[method] = err
+}
+
+// SetLatency delays every call by d. The delay is interrupted when the context is done.
+func (c *Client) SetLatency(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.latency = d
+}
+
+// Calls returns how many times method was called, including failed calls.
+func (c *Client) Calls(method string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.calls[method]
+}
+
+// before is called at the start of every method.
+func (c *Client) before(ctx context.Context, method string) error {
+	c.mu.Lock()
+	c.calls[method]++
+	err := c.errors[method]
+	latency := c.latency
+	hook := c.Hook
+	c.mu.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if hook != nil {
+		return hook(ctx, method)
+	}
+
+	return nil
+}
+
+/* DATA SOURCE */
+
+type dataSource struct {
+	c *Client
+}
+
+func (d *dataSource) Database() interfaces.DataSourceDatabase {
+	return &dataSourceDatabase{c: d.c}
+}
+
+func (d *dataSource) User() interfaces.DataSourceUser {
+	return &dataSourceUser{c: d.c}
+}
+
+func (d *dataSource) ReplicaSet() interfaces.DataSourceReplicaSet {
+	return &dataSourceReplicaSet{c: d.c}
+}
+
+func (d *dataSource) Sharding() interfaces.DataSourceSharding {
+	return &dataSourceSharding{c: d.c}
+}
+
+func (d *dataSource) Collection() interfaces.DataSourceCollection {
+	return &dataSourceCollection{c: d.c}
+}
+
+func (d *dataSource) Index() interfaces.DataSourceIndex {
+	return &dataSourceIndex{c: d.c}
+}
+
+/* RESOURCE */
+
+type resource struct {
+	c *Client
+}
+
+func (r *resource) Database() interfaces.ResourceDatabase {
+	return &resourceDatabase{c: r.c}
+}
+
+func (r *resource) User() interfaces.ResourceUser {
+	return &resourceUser{c: r.c}
+}
+
+func (r *resource) ReplicaSet() interfaces.ResourceReplicaSet {
+	return &resourceReplicaSet{c: r.c}
+}
+
+func (r *resource) Shard() interfaces.ResourceShard {
+	return &resourceShard{c: r.c}
+}
+
+func (r *resource) ShardedCollection() interfaces.ResourceShardedCollection {
+	return &resourceShardedCollection{c: r.c}
+}
+
+func (r *resource) ShardZone() interfaces.ResourceShardZone {
+	return &resourceShardZone{c: r.c}
+}
+
+func (r *resource) ZoneKeyRange() interfaces.ResourceZoneKeyRange {
+	return &resourceZoneKeyRange{c: r.c}
+}
+
+func (r *resource) Balancer() interfaces.ResourceBalancer {
+	return &resourceBalancer{c: r.c}
+}
+
+func (r *resource) DefaultRWConcern() interfaces.ResourceDefaultRWConcern {
+	return &resourceDefaultRWConcern{c: r.c}
+}
+
+func (r *resource) ServerParameter() interfaces.ResourceServerParameter {
+	return &resourceServerParameter{c: r.c}
+}
+
+func (r *resource) ClusterParameter() interfaces.ResourceClusterParameter {
+	return &resourceClusterParameter{c: r.c}
+}
+
+func (r *resource) FeatureCompatibilityVersion() interfaces.ResourceFeatureCompatibilityVersion {
+	return &resourceFeatureCompatibilityVersion{c: r.c}
+}
+
+func (r *resource) Profiler() interfaces.ResourceProfiler {
+	return &resourceProfiler{c: r.c}
+}
+
+func (r *resource) View() interfaces.ResourceView {
+	return &resourceView{c: r.c}
+}
+
+func (r *resource) TimeSeriesCollection() interfaces.ResourceTimeSeriesCollection {
+	return &resourceTimeSeriesCollection{c: r.c}
+}
+
+func (r *resource) ClusteredCollection() interfaces.ResourceClusteredCollection {
+	return &resourceClusteredCollection{c: r.c}
+}
@@ -0,0 +1,89 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+// DefaultFeatureCompatibilityVersion is the feature compatibility version of a new model, the one of MongoDB 6.
+const DefaultFeatureCompatibilityVersion = "6.0"
+
+type resourceFeatureCompatibilityVersion struct {
+	c *Client
+}
+
+// SetFeatureCompatibilityVersion sets the feature compatibility version in the model, bypassing the hooks.
+func (c *Client) SetFeatureCompatibilityVersion(version string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.fcv = version
+}
+
+// GetFeatureCompatibilityVersion returns the feature compatibility version stored in the model.
+func (c *Client) GetFeatureCompatibilityVersion() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.fcv
+}
+
+func (r *resourceFeatureCompatibilityVersion) Create(ctx context.Context, plan types.FeatureCompatibilityVersion) error {
+	if err := r.c.before(ctx, MethodResourceFeatureCompatibilityVersionCreate); err != nil {
+		return err
+	}
+
+	return r.c.setFeatureCompatibilityVersion(plan)
+}
+
+func (r *resourceFeatureCompatibilityVersion) Update(ctx context.Context, plan types.FeatureCompatibilityVersion) error {
+	if err := r.c.before(ctx, MethodResourceFeatureCompatibilityVersionUpdate); err != nil {
+		return err
+	}
+
+	return r.c.setFeatureCompatibilityVersion(plan)
+}
+
+// Delete keeps the version, like the mongodb package.
+func (r *resourceFeatureCompatibilityVersion) Delete(ctx context.Context, _ types.FeatureCompatibilityVersion) error {
+	return r.c.before(ctx, MethodResourceFeatureCompatibilityVersionDelete)
+}
+
+func (r *resourceFeatureCompatibilityVersion) Read(ctx context.Context, state types.FeatureCompatibilityVersion) (types.FeatureCompatibilityVersion, error) {
+	if err := r.c.before(ctx, MethodResourceFeatureCompatibilityVersionRead); err != nil {
+		return state, err
+	}
+
+	state.Version = r.c.GetFeatureCompatibilityVersion()
+
+	return state, nil
+}
+
+func (r *resourceFeatureCompatibilityVersion) ImportState(ctx context.Context) (types.FeatureCompatibilityVersion, error) {
+	if err := r.c.before(ctx, MethodResourceFeatureCompatibilityVersionImportState); err != nil {
+		return types.FeatureCompatibilityVersion{}, err
+	}
+
+	return types.FeatureCompatibilityVersion{Version: r.c.GetFeatureCompatibilityVersion()}, nil
+}
+
+// setFeatureCompatibilityVersion sets the version, refusing downgrades which are not allowed like the mongodb package.
+func (c *Client) setFeatureCompatibilityVersion(plan types.FeatureCompatibilityVersion) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cmp, err := types.CompareFeatureCompatibilityVersions(plan.Version, c.fcv)
+	if err != nil {
+		return err
+	}
+
+	if cmp < 0 && !plan.IsDowngradeAllowed() {
+		return fmt.Errorf("downgrading the feature compatibility version from %s to %s requires allow_downgrade", c.fcv, plan.Version)
+	}
+
+	c.fcv = plan.Version
+
+	return nil
+}
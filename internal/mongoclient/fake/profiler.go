@@ -0,0 +1,152 @@
+package fake
+
+import (
+	"context"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+type resourceProfiler struct {
+	c *Client
+}
+
+// SetProfiler sets the profiler of the database in the model, bypassing the hooks. Unlike mongod,
+// the model keeps slowms and the sample rate per database.
+func (c *Client) SetProfiler(p types.Profiler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.profilers[p.Database] = profilerSettings(p)
+}
+
+// GetProfiler returns the profiler of the database stored in the model, level 0 with the mongod defaults
+// if it was never set.
+func (c *Client) GetProfiler(database string) types.Profiler {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	p, ok := c.profilers[database]
+	if !ok {
+		slowMS := int64(types.ProfilerDefaultSlowMS)
+		sampleRate := types.ProfilerDefaultSampleRate
+
+		return types.Profiler{Database: database, SlowMS: &slowMS, SampleRate: &sampleRate}
+	}
+
+	return profilerSettings(p)
+}
+
+func (r *resourceProfiler) Create(ctx context.Context, plan types.Profiler) error {
+	if err := r.c.before(ctx, MethodResourceProfilerCreate); err != nil {
+		return err
+	}
+
+	r.c.setProfiler(plan)
+
+	return nil
+}
+
+func (r *resourceProfiler) Update(ctx context.Context, plan types.Profiler) error {
+	if err := r.c.before(ctx, MethodResourceProfilerUpdate); err != nil {
+		return err
+	}
+
+	r.c.setProfiler(plan)
+
+	return nil
+}
+
+// Delete turns the profiler off and removes its filter, keeping slowms and the sample rate like the mongodb package.
+func (r *resourceProfiler) Delete(ctx context.Context, state types.Profiler) error {
+	if err := r.c.before(ctx, MethodResourceProfilerDelete); err != nil {
+		return err
+	}
+
+	p := r.c.GetProfiler(state.Database)
+	p.Level = 0
+	p.Filter = nil
+
+	r.c.SetProfiler(p)
+
+	return nil
+}
+
+// Read returns the stored settings, keeping slowms and the sample rate unset in the state unset like the mongodb package.
+// Filters are compared as strings.
+func (r *resourceProfiler) Read(ctx context.Context, state types.Profiler) (types.Profiler, error) {
+	if err := r.c.before(ctx, MethodResourceProfilerRead); err != nil {
+		return state, err
+	}
+
+	current := r.c.GetProfiler(state.Database)
+
+	state.Level = current.Level
+	state.Filter = current.Filter
+
+	if state.SlowMS != nil {
+		state.SlowMS = current.SlowMS
+	}
+
+	if state.SampleRate != nil {
+		state.SampleRate = current.SampleRate
+	}
+
+	return state, nil
+}
+
+func (r *resourceProfiler) ImportState(ctx context.Context, database string) (types.Profiler, error) {
+	if err := r.c.before(ctx, MethodResourceProfilerImportState); err != nil {
+		return types.Profiler{}, err
+	}
+
+	p := r.c.GetProfiler(database)
+
+	if p.SlowMS != nil && *p.SlowMS == types.ProfilerDefaultSlowMS {
+		p.SlowMS = nil
+	}
+
+	if p.SampleRate != nil && *p.SampleRate == types.ProfilerDefaultSampleRate {
+		p.SampleRate = nil
+	}
+
+	return p, nil
+}
+
+// setProfiler applies the plan to the stored settings: unset slowms and sample rate keep their values.
+func (c *Client) setProfiler(plan types.Profiler) {
+	p := c.GetProfiler(plan.Database)
+	p.Level = plan.Level
+	p.Filter = plan.Filter
+
+	if plan.SlowMS != nil {
+		p.SlowMS = plan.SlowMS
+	}
+
+	if plan.SampleRate != nil {
+		p.SampleRate = plan.SampleRate
+	}
+
+	c.SetProfiler(p)
+}
+
+// profilerSettings returns a copy of the settings without the resource-only attributes.
+func profilerSettings(p types.Profiler) types.Profiler {
+	s := types.Profiler{Database: p.Database, Level: p.Level}
+
+	if p.SlowMS != nil {
+		slowMS := *p.SlowMS
+		s.SlowMS = &slowMS
+	}
+
+	if p.SampleRate != nil {
+		sampleRate := *p.SampleRate
+		s.SampleRate = &sampleRate
+	}
+
+	if p.Filter != nil {
+		filter := *p.Filter
+		s.Filter = &filter
+	}
+
+	return s
+}
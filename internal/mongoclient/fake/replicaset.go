@@ -0,0 +1,166 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+type dataSourceReplicaSet struct {
+	c *Client
+}
+
+type resourceReplicaSet struct {
+	c *Client
+}
+
+// SetReplicaSet replaces the replica set configuration in the model, bypassing the hooks.
+// A nil config means the replica set is not initialized.
+func (c *Client) SetReplicaSet(config *types.ReplicaSet) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if config == nil {
+		c.replicaSet = nil
+		return
+	}
+
+	rs := copyReplicaSet(*config)
+	c.replicaSet = &rs
+}
+
+// GetReplicaSet returns the replica set configuration stored in the model, including its version.
+func (c *Client) GetReplicaSet() (types.ReplicaSet, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.replicaSet == nil {
+		return types.ReplicaSet{}, false
+	}
+
+	return copyReplicaSet(*c.replicaSet), true
+}
+
+func (d *dataSourceReplicaSet) Read(ctx context.Context) (types.ReplicaSet, error) {
+	if err := d.c.before(ctx, MethodDataSourceReplicaSetRead); err != nil {
+		return types.ReplicaSet{}, err
+	}
+
+	rs, err := d.c.replicaSetConfig()
+	if err != nil {
+		return types.ReplicaSet{}, err
+	}
+
+	rs.ClearVersion()
+
+	return rs, nil
+}
+
+func (r *resourceReplicaSet) Create(ctx context.Context, plan types.ReplicaSet) error {
+	if err := r.c.before(ctx, MethodResourceReplicaSetCreate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	if r.c.replicaSet != nil {
+		return fmt.Errorf("create replica set failed with error: already initialized")
+	}
+
+	version := int64(1)
+
+	rs := copyReplicaSet(plan)
+	rs.SetVersion(&version)
+	r.c.replicaSet = &rs
+
+	return nil
+}
+
+func (r *resourceReplicaSet) Update(ctx context.Context, plan types.ReplicaSet) error {
+	if err := r.c.before(ctx, MethodResourceReplicaSetUpdate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	if r.c.replicaSet == nil || r.c.replicaSet.Name != plan.Name {
+		return fmt.Errorf("replica set %s not ready or corrupted", plan.Name)
+	}
+
+	version := int64(1)
+	if r.c.replicaSet.Version != nil {
+		version = *r.c.replicaSet.Version + 1
+	}
+
+	rs := copyReplicaSet(plan)
+	rs.SetVersion(&version)
+	r.c.replicaSet = &rs
+
+	return nil
+}
+
+func (r *resourceReplicaSet) Exists(ctx context.Context, state types.ReplicaSet) (bool, error) {
+	if err := r.c.before(ctx, MethodResourceReplicaSetExists); err != nil {
+		return false, err
+	}
+
+	rs, err := r.c.replicaSetConfig()
+	if err != nil {
+		return false, fmt.Errorf("failed to check if replica set exists: %w", err)
+	}
+
+	return rs.Name == state.Name, nil
+}
+
+func (r *resourceReplicaSet) ImportState(ctx context.Context, name string) (types.ReplicaSet, error) {
+	if err := r.c.before(ctx, MethodResourceReplicaSetImportState); err != nil {
+		return types.ReplicaSet{}, err
+	}
+
+	rs, err := r.c.replicaSetConfig()
+	if err != nil {
+		return types.ReplicaSet{}, err
+	}
+
+	if rs.Name != name {
+		return types.ReplicaSet{}, fmt.Errorf("replica set %s does not exist", name)
+	}
+
+	rs.RemoveDefaults()
+
+	return rs, nil
+}
+
+// replicaSetConfig returns a copy of the replica set configuration or the error MongoDB returns
+// when the replica set is not initialized.
+func (c *Client) replicaSetConfig() (types.ReplicaSet, error) {
+	rs, ok := c.GetReplicaSet()
+	if !ok {
+		return types.ReplicaSet{}, fmt.Errorf("replica set not initialized. Please create, plan and apply mongodb_replicaset resource first")
+	}
+
+	return rs, nil
+}
+
+// copyReplicaSet returns a copy of the configuration which does not share the members slice
+// and drops the Terraform-only fields, like the real MongoDB would.
+func copyReplicaSet(rs types.ReplicaSet) types.ReplicaSet {
+	cp := types.ReplicaSet{
+		Name:                               rs.Name,
+		Version:                            rs.Version,
+		Members:                            slices.Clone(rs.Members),
+		ProtocolVersion:                    rs.ProtocolVersion,
+		WriteConcernMajorityJournalDefault: rs.WriteConcernMajorityJournalDefault,
+	}
+
+	if rs.Settings != nil {
+		settings := *rs.Settings
+		cp.Settings = &settings
+	}
+
+	return cp
+}
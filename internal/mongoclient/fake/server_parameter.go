@@ -0,0 +1,175 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+// DefaultHost is the host parameters are set on when neither a host nor all members are given,
+// the first host of the connection string in the mongodb package.
+const DefaultHost = "localhost:27017"
+
+type resourceServerParameter struct {
+	c *Client
+}
+
+// SetServerParameter sets the parameter of the host in the model, bypassing the hooks. Parameters must be set
+// before they are managed, like the parameters known to mongod.
+func (c *Client) SetServerParameter(host, name, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.parameters[host] == nil {
+		c.parameters[host] = map[string]string{}
+	}
+
+	c.parameters[host][name] = value
+}
+
+// GetServerParameter returns the parameter of the host stored in the model.
+func (c *Client) GetServerParameter(host, name string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	value, ok := c.parameters[host][name]
+
+	return value, ok
+}
+
+// Create sets the parameter on its hosts in order and, like the mongodb package, returns the previous values
+// of the hosts already set when a host fails.
+func (r *resourceServerParameter) Create(ctx context.Context, plan types.ServerParameter) (types.ServerParameter, error) {
+	if err := r.c.before(ctx, MethodResourceServerParameterCreate); err != nil {
+		return plan, err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	hosts, err := r.c.parameterHosts(plan)
+	if err != nil {
+		return plan, err
+	}
+
+	plan.PreviousValues = map[string]string{}
+
+	for _, host := range hosts {
+		previous, ok := r.c.parameters[host][plan.Name]
+		if !ok {
+			return plan, fmt.Errorf("parameter %s not found on %s", plan.Name, host)
+		}
+
+		plan.PreviousValues[host] = previous
+		r.c.parameters[host][plan.Name] = plan.Value
+	}
+
+	return plan, nil
+}
+
+func (r *resourceServerParameter) Update(ctx context.Context, plan types.ServerParameter) error {
+	if err := r.c.before(ctx, MethodResourceServerParameterUpdate); err != nil {
+		return err
+	}
+
+	for host := range plan.PreviousValues {
+		r.c.SetServerParameter(host, plan.Name, plan.Value)
+	}
+
+	return nil
+}
+
+func (r *resourceServerParameter) Delete(ctx context.Context, state types.ServerParameter) error {
+	if err := r.c.before(ctx, MethodResourceServerParameterDelete); err != nil {
+		return err
+	}
+
+	for host, previous := range state.PreviousValues {
+		r.c.SetServerParameter(host, state.Name, previous)
+	}
+
+	return nil
+}
+
+// Read returns the first value which differs from the state, like the mongodb package. Values are compared as strings.
+func (r *resourceServerParameter) Read(ctx context.Context, state types.ServerParameter) (types.ServerParameter, error) {
+	if err := r.c.before(ctx, MethodResourceServerParameterRead); err != nil {
+		return state, err
+	}
+
+	for _, host := range slices.Sorted(maps.Keys(state.PreviousValues)) {
+		current, ok := r.c.GetServerParameter(host, state.Name)
+		if !ok {
+			return state, fmt.Errorf("parameter %s not found on %s", state.Name, host)
+		}
+
+		if current != state.Value {
+			state.Value = current
+			break
+		}
+	}
+
+	return state, nil
+}
+
+func (r *resourceServerParameter) ImportState(ctx context.Context, id string) (types.ServerParameter, error) {
+	if err := r.c.before(ctx, MethodResourceServerParameterImportState); err != nil {
+		return types.ServerParameter{}, err
+	}
+
+	p, ok := types.ParseServerParameterID(id)
+	if !ok {
+		return types.ServerParameter{}, fmt.Errorf("invalid import ID %q", id)
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	hosts, err := r.c.parameterHosts(p)
+	if err != nil {
+		return types.ServerParameter{}, err
+	}
+
+	p.PreviousValues = map[string]string{}
+
+	for _, host := range hosts {
+		current, ok := r.c.parameters[host][p.Name]
+		if !ok {
+			return types.ServerParameter{}, fmt.Errorf("parameter %s not found on %s", p.Name, host)
+		}
+
+		p.PreviousValues[host] = current
+
+		if p.Value == "" {
+			p.Value = current
+		}
+	}
+
+	return p, nil
+}
+
+// parameterHosts returns the hosts of the parameter, the members of the replica set in the model for all members.
+// The caller must hold the lock.
+func (c *Client) parameterHosts(p types.ServerParameter) ([]string, error) {
+	if p.Host != nil {
+		return []string{*p.Host}, nil
+	}
+
+	if !p.IsAllMembers() {
+		return []string{DefaultHost}, nil
+	}
+
+	if c.replicaSet == nil {
+		return nil, fmt.Errorf("replica set is not initialized")
+	}
+
+	hosts := make([]string, 0, len(c.replicaSet.Members))
+	for _, m := range c.replicaSet.Members {
+		hosts = append(hosts, m.Host)
+	}
+
+	return hosts, nil
+}
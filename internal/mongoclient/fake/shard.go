@@ -0,0 +1,96 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+type resourceShard struct {
+	c *Client
+}
+
+// AddShard adds a shard to the model, bypassing the hooks.
+func (c *Client) AddShard(name, host string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.shards[name] = host
+}
+
+// RemoveShard removes a shard from the model, bypassing the hooks. Use it to simulate drift.
+func (c *Client) RemoveShard(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.shards, name)
+}
+
+// GetShard returns the host of the shard stored in the model.
+func (c *Client) GetShard(name string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	host, ok := c.shards[name]
+
+	return host, ok
+}
+
+func (r *resourceShard) Create(ctx context.Context, plan types.Shard) error {
+	if err := r.c.before(ctx, MethodResourceShardCreate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	if _, ok := r.c.shards[plan.Name]; ok {
+		return fmt.Errorf("shard %s already exists", plan.Name)
+	}
+
+	r.c.shards[plan.Name] = plan.Host
+
+	return nil
+}
+
+// Delete removes the shard at once, draining is not modelled.
+func (r *resourceShard) Delete(ctx context.Context, state types.Shard) error {
+	if err := r.c.before(ctx, MethodResourceShardDelete); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	delete(r.c.shards, state.Name)
+
+	return nil
+}
+
+// Read keeps the host of the state if it names the same replica set, like the mongodb package.
+func (r *resourceShard) Read(ctx context.Context, state types.Shard) (types.Shard, bool, error) {
+	if err := r.c.before(ctx, MethodResourceShardRead); err != nil {
+		return state, false, err
+	}
+
+	host, ok := r.c.GetShard(state.Name)
+	if ok && !types.SameShardHost(state.Host, host) {
+		state.Host = host
+	}
+
+	return state, ok, nil
+}
+
+func (r *resourceShard) ImportState(ctx context.Context, name string) (types.Shard, error) {
+	if err := r.c.before(ctx, MethodResourceShardImportState); err != nil {
+		return types.Shard{}, err
+	}
+
+	host, ok := r.c.GetShard(name)
+	if !ok {
+		return types.Shard{}, fmt.Errorf("shard %s does not exist", name)
+	}
+
+	return types.Shard{Name: name, Host: host}, nil
+}
@@ -0,0 +1,144 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+type resourceShardedCollection struct {
+	c *Client
+}
+
+// ShardCollection adds a sharded collection to the model, bypassing the hooks. Only the database,
+// collection, key and unique flag are kept, like in config.collections.
+func (c *Client) ShardCollection(collection types.ShardedCollection) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.collections[collection.Namespace()] = shardingMetadata(collection)
+}
+
+// DropShardedCollection removes a sharded collection from the model, bypassing the hooks. Use it to simulate drift.
+func (c *Client) DropShardedCollection(namespace string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.collections, namespace)
+}
+
+// GetShardedCollection returns the sharded collection stored in the model.
+func (c *Client) GetShardedCollection(namespace string) (types.ShardedCollection, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	collection, ok := c.collections[namespace]
+
+	return collection, ok
+}
+
+func (r *resourceShardedCollection) Create(ctx context.Context, plan types.ShardedCollection) error {
+	if err := r.c.before(ctx, MethodResourceShardedCollectionCreate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	// A collection sharded with the planned key and uniqueness is taken as created, like in the mongodb package
+	if current, ok := r.c.collections[plan.Namespace()]; ok {
+		if current.Key.Equal(plan.Key) && isUnique(current) == isUnique(plan) {
+			return nil
+		}
+
+		return fmt.Errorf("collection %s is already sharded with another key", plan.Namespace())
+	}
+
+	r.c.collections[plan.Namespace()] = shardingMetadata(plan)
+
+	return nil
+}
+
+// Update refines or reshards the collection at once, with the same restriction on unique keys as the mongodb package.
+func (r *resourceShardedCollection) Update(ctx context.Context, plan types.ShardedCollection) error {
+	if err := r.c.before(ctx, MethodResourceShardedCollectionUpdate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	current, ok := r.c.collections[plan.Namespace()]
+	if !ok {
+		return fmt.Errorf("collection %s is not sharded", plan.Namespace())
+	}
+
+	if !current.Key.Equal(plan.Key) && !current.Key.Refines(plan.Key) && current.Unique != nil && *current.Unique {
+		return fmt.Errorf("the unique shard key of %s can only be refined by appending fields, resharding does not support unique keys", plan.Namespace())
+	}
+
+	current.Key = append(types.ShardKey{}, plan.Key...)
+	r.c.collections[plan.Namespace()] = current
+
+	return nil
+}
+
+func (r *resourceShardedCollection) Read(ctx context.Context, state types.ShardedCollection) (types.ShardedCollection, bool, error) {
+	if err := r.c.before(ctx, MethodResourceShardedCollectionRead); err != nil {
+		return state, false, err
+	}
+
+	current, ok := r.c.GetShardedCollection(state.Namespace())
+	if !ok {
+		return state, false, nil
+	}
+
+	state.Key = current.Key
+
+	if state.Unique != nil || current.Unique != nil {
+		unique := current.Unique != nil && *current.Unique
+		state.Unique = &unique
+	}
+
+	return state, true, nil
+}
+
+func (r *resourceShardedCollection) ImportState(ctx context.Context, namespace string) (types.ShardedCollection, error) {
+	if err := r.c.before(ctx, MethodResourceShardedCollectionImportState); err != nil {
+		return types.ShardedCollection{}, err
+	}
+
+	if !strings.Contains(namespace, ".") {
+		return types.ShardedCollection{}, fmt.Errorf("invalid import ID %q, expected <database>.<collection>", namespace)
+	}
+
+	current, ok := r.c.GetShardedCollection(namespace)
+	if !ok {
+		return types.ShardedCollection{}, fmt.Errorf("collection %s is not sharded", namespace)
+	}
+
+	return current, nil
+}
+
+// shardingMetadata returns the part of the collection stored in config.collections. A false unique flag is stored as unset.
+func shardingMetadata(collection types.ShardedCollection) types.ShardedCollection {
+	m := types.ShardedCollection{
+		Database:   collection.Database,
+		Collection: collection.Collection,
+		Key:        append(types.ShardKey{}, collection.Key...),
+	}
+
+	if collection.Unique != nil && *collection.Unique {
+		unique := true
+		m.Unique = &unique
+	}
+
+	return m
+}
+
+// isUnique reports whether the shard key of the collection is unique, unset is false.
+func isUnique(c types.ShardedCollection) bool {
+	return c.Unique != nil && *c.Unique
+}
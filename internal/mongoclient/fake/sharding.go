@@ -0,0 +1,110 @@
+package fake
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sort"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+type dataSourceSharding struct {
+	c *Client
+}
+
+// SetPrimaryShard sets the primary shard of the database in the model, bypassing the hooks.
+// The database is created if it does not exist.
+func (c *Client) SetPrimaryShard(database, shard string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.databases[database] = struct{}{}
+	c.primaries[database] = shard
+}
+
+// SetChunks sets the number of chunks of the collection on the shard in the model, bypassing the hooks.
+func (c *Client) SetChunks(namespace, shard string, chunks int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.chunks[namespace] == nil {
+		c.chunks[namespace] = map[string]int64{}
+	}
+
+	c.chunks[namespace][shard] = chunks
+}
+
+// Read returns the topology built from the shards, databases, sharded collections, zones and balancer of the model.
+// Shards are never draining in the model.
+func (d *dataSourceSharding) Read(ctx context.Context) (types.Sharding, error) {
+	if err := d.c.before(ctx, MethodDataSourceShardingRead); err != nil {
+		return types.Sharding{}, err
+	}
+
+	d.c.mu.Lock()
+	defer d.c.mu.Unlock()
+
+	mode := "full"
+	if !d.c.balancer.Enabled {
+		mode = types.BalancerModeOff
+	}
+
+	s := types.Sharding{
+		Shards:      []types.ShardingShard{},
+		Databases:   []types.ShardingDatabase{},
+		Collections: []types.ShardingCollection{},
+		Balancer:    types.BalancerStatus{Mode: mode},
+	}
+
+	shardChunks := map[string]int64{}
+	for _, counts := range d.c.chunks {
+		for shard, n := range counts {
+			shardChunks[shard] += n
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(d.c.shards)) {
+		s.Shards = append(s.Shards, types.ShardingShard{
+			Name:   name,
+			Host:   d.c.shards[name],
+			State:  types.ShardStateActive,
+			Zones:  append([]string{}, d.c.zones[name]...),
+			Chunks: shardChunks[name],
+		})
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(d.c.databases)) {
+		if slices.Contains(types.DefaultDatabases, name) {
+			continue
+		}
+
+		s.Databases = append(s.Databases, types.ShardingDatabase{Name: name, PrimaryShard: d.c.primaries[name]})
+	}
+
+	for _, collection := range d.c.collections {
+		counts := maps.Clone(d.c.chunks[collection.Namespace()])
+		if counts == nil {
+			counts = map[string]int64{}
+		}
+
+		s.Collections = append(s.Collections, types.ShardingCollection{
+			Database:   collection.Database,
+			Collection: collection.Collection,
+			Key:        slices.Clone(collection.Key),
+			Unique:     collection.Unique != nil && *collection.Unique,
+			Balancing:  !slices.Contains(d.c.balancer.DisabledCollections, collection.Namespace()),
+			Chunks:     counts,
+		})
+	}
+
+	sort.Slice(s.Collections, func(i, j int) bool {
+		if s.Collections[i].Database != s.Collections[j].Database {
+			return s.Collections[i].Database < s.Collections[j].Database
+		}
+
+		return s.Collections[i].Collection < s.Collections[j].Collection
+	})
+
+	return s, nil
+}
@@ -0,0 +1,176 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+type resourceTimeSeriesCollection struct {
+	c *Client
+}
+
+// SetTimeSeriesCollection stores the collection in the model, bypassing the hooks. Use it to simulate drift.
+func (c *Client) SetTimeSeriesCollection(ts types.TimeSeriesCollection) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.timeSeries[ts.Namespace()] = timeSeriesDefinition(ts)
+}
+
+// RemoveTimeSeriesCollection drops the collection from the model, bypassing the hooks. Use it to simulate drift.
+func (c *Client) RemoveTimeSeriesCollection(namespace string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.timeSeries, namespace)
+}
+
+// GetTimeSeriesCollection returns the collection stored in the model.
+func (c *Client) GetTimeSeriesCollection(namespace string) (types.TimeSeriesCollection, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ts, ok := c.timeSeries[namespace]
+
+	return timeSeriesDefinition(ts), ok
+}
+
+// Create stores the collection and its database, like MongoDB creates the database of a new collection.
+func (r *resourceTimeSeriesCollection) Create(ctx context.Context, plan types.TimeSeriesCollection) error {
+	if err := r.c.before(ctx, MethodResourceTimeSeriesCollectionCreate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	if _, ok := r.c.timeSeries[plan.Namespace()]; ok {
+		return fmt.Errorf("time-series collection %s already exists", plan.Namespace())
+	}
+
+	r.c.timeSeries[plan.Namespace()] = timeSeriesDefinition(plan)
+	r.c.databases[plan.Database] = struct{}{}
+
+	return nil
+}
+
+// Update replaces the bucketing and the expiration of the collection. Like collMod, it refuses
+// finer granularities and smaller buckets.
+func (r *resourceTimeSeriesCollection) Update(ctx context.Context, plan types.TimeSeriesCollection) error {
+	if err := r.c.before(ctx, MethodResourceTimeSeriesCollectionUpdate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	current, ok := r.c.timeSeries[plan.Namespace()]
+	if !ok {
+		return fmt.Errorf("time-series collection %s does not exist", plan.Namespace())
+	}
+
+	if plan.Granularity != nil {
+		granularity := types.TimeSeriesGranularitySeconds
+		if current.Granularity != nil {
+			granularity = *current.Granularity
+		}
+
+		if types.CompareTimeSeriesGranularities(*plan.Granularity, granularity) < 0 {
+			return fmt.Errorf("cannot change granularity of %s from %s to %s", plan.Namespace(), granularity, *plan.Granularity)
+		}
+	}
+
+	if plan.BucketMaxSpanSeconds != nil && current.BucketMaxSpanSeconds != nil && *plan.BucketMaxSpanSeconds < *current.BucketMaxSpanSeconds {
+		return fmt.Errorf("cannot decrease bucket_max_span_seconds of %s", plan.Namespace())
+	}
+
+	next := timeSeriesDefinition(plan)
+	next.TimeField = current.TimeField
+	next.MetaField = current.MetaField
+
+	if plan.Granularity == nil && !plan.HasCustomBuckets() {
+		next.Granularity = current.Granularity
+		next.BucketMaxSpanSeconds = current.BucketMaxSpanSeconds
+		next.BucketRoundingSeconds = current.BucketRoundingSeconds
+	}
+
+	r.c.timeSeries[plan.Namespace()] = next
+
+	return nil
+}
+
+func (r *resourceTimeSeriesCollection) Delete(ctx context.Context, state types.TimeSeriesCollection) error {
+	if err := r.c.before(ctx, MethodResourceTimeSeriesCollectionDelete); err != nil {
+		return err
+	}
+
+	r.c.RemoveTimeSeriesCollection(state.Namespace())
+
+	return nil
+}
+
+// Read returns the stored collection. Unlike the mongodb package, the fake does not report the default granularity.
+func (r *resourceTimeSeriesCollection) Read(ctx context.Context, state types.TimeSeriesCollection) (types.TimeSeriesCollection, bool, error) {
+	if err := r.c.before(ctx, MethodResourceTimeSeriesCollectionRead); err != nil {
+		return state, false, err
+	}
+
+	current, ok := r.c.GetTimeSeriesCollection(state.Namespace())
+	if !ok {
+		return state, false, nil
+	}
+
+	state.TimeField = current.TimeField
+	state.MetaField = current.MetaField
+	state.Granularity = current.Granularity
+	state.BucketMaxSpanSeconds = current.BucketMaxSpanSeconds
+	state.BucketRoundingSeconds = current.BucketRoundingSeconds
+	state.ExpireAfterSeconds = current.ExpireAfterSeconds
+
+	return state, true, nil
+}
+
+func (r *resourceTimeSeriesCollection) ImportState(ctx context.Context, namespace string) (types.TimeSeriesCollection, error) {
+	if err := r.c.before(ctx, MethodResourceTimeSeriesCollectionImportState); err != nil {
+		return types.TimeSeriesCollection{}, err
+	}
+
+	if !strings.Contains(namespace, ".") {
+		return types.TimeSeriesCollection{}, fmt.Errorf("invalid import ID %q, expected <database>.<collection>", namespace)
+	}
+
+	current, ok := r.c.GetTimeSeriesCollection(namespace)
+	if !ok {
+		return types.TimeSeriesCollection{}, fmt.Errorf("time-series collection %s does not exist", namespace)
+	}
+
+	return current, nil
+}
+
+// timeSeriesDefinition returns a copy of the collection without the resource-only attributes.
+func timeSeriesDefinition(ts types.TimeSeriesCollection) types.TimeSeriesCollection {
+	return types.TimeSeriesCollection{
+		Database:              ts.Database,
+		Name:                  ts.Name,
+		TimeField:             ts.TimeField,
+		MetaField:             copyPtr(ts.MetaField),
+		Granularity:           copyPtr(ts.Granularity),
+		BucketMaxSpanSeconds:  copyPtr(ts.BucketMaxSpanSeconds),
+		BucketRoundingSeconds: copyPtr(ts.BucketRoundingSeconds),
+		ExpireAfterSeconds:    copyPtr(ts.ExpireAfterSeconds),
+	}
+}
+
+// copyPtr returns a pointer to a copy of the value, or nil.
+func copyPtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+
+	v := *p
+
+	return &v
+}
@@ -0,0 +1,179 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+type dataSourceUser struct {
+	c *Client
+}
+
+type resourceUser struct {
+	c *Client
+}
+
+// AddUser creates a user in the model, bypassing the hooks.
+func (c *Client) AddUser(user types.User) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.users[user.Username] = copyUser(user)
+}
+
+// RemoveUser drops a user from the model, bypassing the hooks. Use it to simulate drift.
+func (c *Client) RemoveUser(username string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.users, username)
+}
+
+// GetUser returns the user stored in the model.
+func (c *Client) GetUser(username string) (types.User, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	u, ok := c.users[username]
+
+	return copyUser(u), ok
+}
+
+func (d *dataSourceUser) Read(ctx context.Context) (types.Users, error) {
+	if err := d.c.before(ctx, MethodDataSourceUserRead); err != nil {
+		return types.Users{}, err
+	}
+
+	d.c.mu.Lock()
+	defer d.c.mu.Unlock()
+
+	names := make([]string, 0, len(d.c.users))
+	for name := range d.c.users {
+		if slices.Contains(types.DefaultUsers, name) {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	if len(names) == 0 {
+		return types.Users{}, fmt.Errorf("users not found. You can create a user using resource mongodb_user")
+	}
+
+	sort.Strings(names)
+
+	us := types.Users{}
+	for _, name := range names {
+		us.Users = append(us.Users, copyUser(d.c.users[name]))
+	}
+
+	return us, nil
+}
+
+func (r *resourceUser) Create(ctx context.Context, plan types.User) error {
+	if slices.Contains(types.DefaultUsers, plan.Username) {
+		return fmt.Errorf("user %s is a default user and cannot be created", plan.Username)
+	}
+
+	if err := r.c.before(ctx, MethodResourceUserCreate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	if _, ok := r.c.users[plan.Username]; ok {
+		return fmt.Errorf("user %s already exists", plan.Username)
+	}
+
+	r.c.users[plan.Username] = copyUser(plan)
+
+	return nil
+}
+
+func (r *resourceUser) Delete(ctx context.Context, state types.User) error {
+	if slices.Contains(types.DefaultUsers, state.Username) {
+		return fmt.Errorf("user %s is a default user and cannot be deleted", state.Username)
+	}
+
+	if err := r.c.before(ctx, MethodResourceUserDelete); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	if _, ok := r.c.users[state.Username]; !ok {
+		return fmt.Errorf("user %s does not exist", state.Username)
+	}
+
+	delete(r.c.users, state.Username)
+
+	return nil
+}
+
+func (r *resourceUser) Update(ctx context.Context, plan types.User) error {
+	if slices.Contains(types.DefaultUsers, plan.Username) {
+		return fmt.Errorf("user %s is a default user and cannot be updated", plan.Username)
+	}
+
+	if err := r.c.before(ctx, MethodResourceUserUpdate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	if _, ok := r.c.users[plan.Username]; !ok {
+		return fmt.Errorf("user %s does not exist", plan.Username)
+	}
+
+	r.c.users[plan.Username] = copyUser(plan)
+
+	return nil
+}
+
+func (r *resourceUser) Exists(ctx context.Context, state types.User) (bool, error) {
+	if err := r.c.before(ctx, MethodResourceUserExists); err != nil {
+		return false, err
+	}
+
+	_, ok := r.c.GetUser(state.Username)
+
+	return ok, nil
+}
+
+func (r *resourceUser) ImportState(ctx context.Context, username string) (types.User, error) {
+	if slices.Contains(types.DefaultUsers, username) {
+		return types.User{}, fmt.Errorf("user %s is a default user and cannot be imported", username)
+	}
+
+	if err := r.c.before(ctx, MethodResourceUserImportState); err != nil {
+		return types.User{}, err
+	}
+
+	u, ok := r.c.GetUser(username)
+	if !ok {
+		return types.User{}, fmt.Errorf("user %s does not exist", username)
+	}
+
+	// MongoDB never returns passwords
+	return types.User{
+		Username: u.Username,
+		Roles:    u.Roles,
+	}, nil
+}
+
+// copyUser returns a copy of the user which does not share the roles slice and drops
+// the Terraform-only fields, like the real MongoDB would.
+func copyUser(u types.User) types.User {
+	return types.User{
+		Username: u.Username,
+		Password: u.Password,
+		Roles:    slices.Clone(u.Roles),
+	}
+}
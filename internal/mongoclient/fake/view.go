@@ -0,0 +1,136 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+type resourceView struct {
+	c *Client
+}
+
+// SetView stores the view in the model, bypassing the hooks. Use it to simulate drift.
+func (c *Client) SetView(v types.View) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.views[v.Namespace()] = viewDefinition(v)
+}
+
+// RemoveView drops the view from the model, bypassing the hooks. Use it to simulate drift.
+func (c *Client) RemoveView(namespace string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.views, namespace)
+}
+
+// GetView returns the view stored in the model.
+func (c *Client) GetView(namespace string) (types.View, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v, ok := c.views[namespace]
+
+	return viewDefinition(v), ok
+}
+
+// Create stores the view and its database, like MongoDB creates the database of a new view.
+func (r *resourceView) Create(ctx context.Context, plan types.View) error {
+	if err := r.c.before(ctx, MethodResourceViewCreate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	if _, ok := r.c.views[plan.Namespace()]; ok {
+		return fmt.Errorf("view %s already exists", plan.Namespace())
+	}
+
+	r.c.views[plan.Namespace()] = viewDefinition(plan)
+	r.c.databases[plan.Database] = struct{}{}
+
+	return nil
+}
+
+// Update replaces the source and the pipeline of the view, keeping its collation like collMod.
+func (r *resourceView) Update(ctx context.Context, plan types.View) error {
+	if err := r.c.before(ctx, MethodResourceViewUpdate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	current, ok := r.c.views[plan.Namespace()]
+	if !ok {
+		return fmt.Errorf("view %s does not exist", plan.Namespace())
+	}
+
+	current.ViewOn = plan.ViewOn
+	current.Pipeline = plan.Pipeline
+	r.c.views[plan.Namespace()] = current
+
+	return nil
+}
+
+func (r *resourceView) Delete(ctx context.Context, state types.View) error {
+	if err := r.c.before(ctx, MethodResourceViewDelete); err != nil {
+		return err
+	}
+
+	r.c.RemoveView(state.Namespace())
+
+	return nil
+}
+
+// Read returns the stored view. Unlike the mongodb package, pipelines are compared as strings.
+func (r *resourceView) Read(ctx context.Context, state types.View) (types.View, bool, error) {
+	if err := r.c.before(ctx, MethodResourceViewRead); err != nil {
+		return state, false, err
+	}
+
+	current, ok := r.c.GetView(state.Namespace())
+	if !ok {
+		return state, false, nil
+	}
+
+	state.ViewOn = current.ViewOn
+	state.Pipeline = current.Pipeline
+	state.Collation = current.Collation
+
+	return state, true, nil
+}
+
+func (r *resourceView) ImportState(ctx context.Context, namespace string) (types.View, error) {
+	if err := r.c.before(ctx, MethodResourceViewImportState); err != nil {
+		return types.View{}, err
+	}
+
+	if !strings.Contains(namespace, ".") {
+		return types.View{}, fmt.Errorf("invalid import ID %q, expected <database>.<view>", namespace)
+	}
+
+	current, ok := r.c.GetView(namespace)
+	if !ok {
+		return types.View{}, fmt.Errorf("view %s does not exist", namespace)
+	}
+
+	return current, nil
+}
+
+// viewDefinition returns a copy of the view without the resource-only attributes.
+func viewDefinition(v types.View) types.View {
+	d := types.View{Database: v.Database, Name: v.Name, ViewOn: v.ViewOn, Pipeline: v.Pipeline}
+
+	if v.Collation != nil {
+		collation := *v.Collation
+		d.Collation = &collation
+	}
+
+	return d
+}
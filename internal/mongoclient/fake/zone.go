@@ -0,0 +1,246 @@
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+)
+
+type resourceShardZone struct {
+	c *Client
+}
+
+type resourceZoneKeyRange struct {
+	c *Client
+}
+
+// AddShardZone adds the shard to the zone in the model, bypassing the hooks.
+func (c *Client) AddShardZone(shard, zone string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.zones[shard] = append(c.zones[shard], zone)
+}
+
+// RemoveShardZone removes the shard from the zone in the model, bypassing the hooks. Use it to simulate drift.
+func (c *Client) RemoveShardZone(shard, zone string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.zones[shard] = slices.DeleteFunc(c.zones[shard], func(z string) bool { return z == zone })
+}
+
+// ShardZones returns the zones of the shard stored in the model.
+func (c *Client) ShardZones(shard string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]string(nil), c.zones[shard]...)
+}
+
+// AddZoneKeyRange adds the range to the model, bypassing the hooks. Only the namespace, zone and bounds are kept.
+func (c *Client) AddZoneKeyRange(r types.ZoneKeyRange) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.zoneRanges = append(c.zoneRanges, types.ZoneKeyRange{
+		Database:   r.Database,
+		Collection: r.Collection,
+		Zone:       r.Zone,
+		Min:        r.Min,
+		Max:        r.Max,
+	})
+}
+
+// RemoveZoneKeyRange removes the range starting at the lower bound from the model, bypassing the hooks. Use it to simulate drift.
+func (c *Client) RemoveZoneKeyRange(namespace, lower string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.zoneRanges = slices.DeleteFunc(c.zoneRanges, func(r types.ZoneKeyRange) bool {
+		return r.Namespace() == namespace && r.Min == lower
+	})
+}
+
+// GetZoneKeyRange returns the range starting at the lower bound stored in the model. Bounds are compared as strings.
+func (c *Client) GetZoneKeyRange(namespace, lower string) (types.ZoneKeyRange, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	i := c.zoneRangeIndex(namespace, lower)
+	if i < 0 {
+		return types.ZoneKeyRange{}, false
+	}
+
+	return c.zoneRanges[i], true
+}
+
+func (c *Client) zoneRangeIndex(namespace, lower string) int {
+	return slices.IndexFunc(c.zoneRanges, func(r types.ZoneKeyRange) bool {
+		return r.Namespace() == namespace && r.Min == lower
+	})
+}
+
+/* SHARD ZONE */
+
+func (r *resourceShardZone) Create(ctx context.Context, plan types.ShardZone) error {
+	if err := r.c.before(ctx, MethodResourceShardZoneCreate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	if _, ok := r.c.shards[plan.Shard]; !ok {
+		return fmt.Errorf("shard %s does not exist", plan.Shard)
+	}
+
+	if slices.Contains(r.c.zones[plan.Shard], plan.Zone) {
+		return fmt.Errorf("shard %s is already in zone %s", plan.Shard, plan.Zone)
+	}
+
+	r.c.zones[plan.Shard] = append(r.c.zones[plan.Shard], plan.Zone)
+
+	return nil
+}
+
+func (r *resourceShardZone) Delete(ctx context.Context, state types.ShardZone) error {
+	if err := r.c.before(ctx, MethodResourceShardZoneDelete); err != nil {
+		return err
+	}
+
+	if !slices.Contains(r.c.ShardZones(state.Shard), state.Zone) {
+		return fmt.Errorf("shard %s is not in zone %s", state.Shard, state.Zone)
+	}
+
+	r.c.RemoveShardZone(state.Shard, state.Zone)
+
+	return nil
+}
+
+func (r *resourceShardZone) Exists(ctx context.Context, state types.ShardZone) (bool, error) {
+	if err := r.c.before(ctx, MethodResourceShardZoneExists); err != nil {
+		return false, err
+	}
+
+	return slices.Contains(r.c.ShardZones(state.Shard), state.Zone), nil
+}
+
+func (r *resourceShardZone) ImportState(ctx context.Context, id string) (types.ShardZone, error) {
+	if err := r.c.before(ctx, MethodResourceShardZoneImportState); err != nil {
+		return types.ShardZone{}, err
+	}
+
+	shard, zone, ok := strings.Cut(id, "/")
+	if !ok || !slices.Contains(r.c.ShardZones(shard), zone) {
+		return types.ShardZone{}, fmt.Errorf("shard zone %s does not exist", id)
+	}
+
+	return types.ShardZone{Shard: shard, Zone: zone}, nil
+}
+
+/* ZONE KEY RANGE */
+
+// Create validates that the bounds have the shard key fields, like the mongodb package.
+func (r *resourceZoneKeyRange) Create(ctx context.Context, plan types.ZoneKeyRange) error {
+	if err := r.c.before(ctx, MethodResourceZoneKeyRangeCreate); err != nil {
+		return err
+	}
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	collection, ok := r.c.collections[plan.Namespace()]
+	if !ok {
+		return fmt.Errorf("collection %s is not sharded", plan.Namespace())
+	}
+
+	for _, bound := range []string{plan.Min, plan.Max} {
+		if err := validateBound(bound, collection.Key); err != nil {
+			return err
+		}
+	}
+
+	if r.c.zoneRangeIndex(plan.Namespace(), plan.Min) >= 0 {
+		return fmt.Errorf("zone key range %s of %s already exists", plan.Min, plan.Namespace())
+	}
+
+	r.c.zoneRanges = append(r.c.zoneRanges, types.ZoneKeyRange{
+		Database:   plan.Database,
+		Collection: plan.Collection,
+		Zone:       plan.Zone,
+		Min:        plan.Min,
+		Max:        plan.Max,
+	})
+
+	return nil
+}
+
+func (r *resourceZoneKeyRange) Delete(ctx context.Context, state types.ZoneKeyRange) error {
+	if err := r.c.before(ctx, MethodResourceZoneKeyRangeDelete); err != nil {
+		return err
+	}
+
+	if _, ok := r.c.GetZoneKeyRange(state.Namespace(), state.Min); !ok {
+		return fmt.Errorf("zone key range %s of %s does not exist", state.Min, state.Namespace())
+	}
+
+	r.c.RemoveZoneKeyRange(state.Namespace(), state.Min)
+
+	return nil
+}
+
+func (r *resourceZoneKeyRange) Read(ctx context.Context, state types.ZoneKeyRange) (types.ZoneKeyRange, bool, error) {
+	if err := r.c.before(ctx, MethodResourceZoneKeyRangeRead); err != nil {
+		return state, false, err
+	}
+
+	current, ok := r.c.GetZoneKeyRange(state.Namespace(), state.Min)
+	if !ok {
+		return state, false, nil
+	}
+
+	state.Zone = current.Zone
+	state.Max = current.Max
+
+	return state, true, nil
+}
+
+func (r *resourceZoneKeyRange) ImportState(ctx context.Context, id string) (types.ZoneKeyRange, error) {
+	if err := r.c.before(ctx, MethodResourceZoneKeyRangeImportState); err != nil {
+		return types.ZoneKeyRange{}, err
+	}
+
+	namespace, lower, _ := strings.Cut(id, "/")
+
+	current, ok := r.c.GetZoneKeyRange(namespace, lower)
+	if !ok {
+		return types.ZoneKeyRange{}, fmt.Errorf("zone key range %s does not exist", id)
+	}
+
+	return current, nil
+}
+
+// validateBound checks that the bound is a JSON document with exactly the shard key fields.
+func validateBound(bound string, key types.ShardKey) error {
+	var d map[string]any
+	if err := json.Unmarshal([]byte(bound), &d); err != nil {
+		return fmt.Errorf("%s is not a JSON document: %w", bound, err)
+	}
+
+	if len(d) != len(key) {
+		return fmt.Errorf("%s must have exactly the shard key fields", bound)
+	}
+
+	for _, f := range key {
+		if _, ok := d[f.Field]; !ok {
+			return fmt.Errorf("%s must have exactly the shard key fields", bound)
+		}
+	}
+
+	return nil
+}
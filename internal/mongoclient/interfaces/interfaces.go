@@ -19,10 +19,13 @@ type DataSource interface {
 	Database() DataSourceDatabase
 	User() DataSourceUser
 	ReplicaSet() DataSourceReplicaSet
+	Sharding() DataSourceSharding
+	Collection() DataSourceCollection
+	Index() DataSourceIndex
 }
 
 type DataSourceDatabase interface {
-	Read(ctx context.Context) (types.Databases, error)
+	Read(ctx context.Context, config types.DataSourceDatabases) (types.DataSourceDatabases, error)
 }
 
 type DataSourceUser interface {
@@ -33,18 +36,44 @@ type DataSourceReplicaSet interface {
 	Read(ctx context.Context) (types.ReplicaSet, error)
 }
 
+type DataSourceSharding interface {
+	Read(ctx context.Context) (types.Sharding, error)
+}
+
+type DataSourceCollection interface {
+	Read(ctx context.Context, config types.Collections) (types.Collections, error)
+}
+
+type DataSourceIndex interface {
+	Read(ctx context.Context, config types.Indexes) (types.Indexes, error)
+}
+
 /* RESOURCE */
 
 type Resource interface {
 	Database() ResourceDatabase
 	User() ResourceUser
 	ReplicaSet() ResourceReplicaSet
+	Shard() ResourceShard
+	ShardedCollection() ResourceShardedCollection
+	ShardZone() ResourceShardZone
+	ZoneKeyRange() ResourceZoneKeyRange
+	Balancer() ResourceBalancer
+	DefaultRWConcern() ResourceDefaultRWConcern
+	ServerParameter() ResourceServerParameter
+	ClusterParameter() ResourceClusterParameter
+	FeatureCompatibilityVersion() ResourceFeatureCompatibilityVersion
+	Profiler() ResourceProfiler
+	View() ResourceView
+	TimeSeriesCollection() ResourceTimeSeriesCollection
+	ClusteredCollection() ResourceClusteredCollection
 }
 
 type ResourceDatabase interface {
 	Create(ctx context.Context, plan types.Database) error
+	Update(ctx context.Context, plan types.Database) error
 	Delete(ctx context.Context, state types.Database) error
-	Exists(ctx context.Context, state types.Database) (bool, error)
+	Read(ctx context.Context, state types.Database) (types.Database, bool, error)
 	ImportState(ctx context.Context, name string) (types.Database, error)
 }
 
@@ -62,3 +91,103 @@ type ResourceReplicaSet interface {
 	Exists(ctx context.Context, state types.ReplicaSet) (bool, error)
 	ImportState(ctx context.Context, name string) (types.ReplicaSet, error)
 }
+
+type ResourceShard interface {
+	Create(ctx context.Context, plan types.Shard) error
+	Delete(ctx context.Context, state types.Shard) error
+	Read(ctx context.Context, state types.Shard) (types.Shard, bool, error)
+	ImportState(ctx context.Context, name string) (types.Shard, error)
+}
+
+type ResourceShardedCollection interface {
+	Create(ctx context.Context, plan types.ShardedCollection) error
+	Update(ctx context.Context, plan types.ShardedCollection) error
+	Read(ctx context.Context, state types.ShardedCollection) (types.ShardedCollection, bool, error)
+	ImportState(ctx context.Context, namespace string) (types.ShardedCollection, error)
+}
+
+type ResourceShardZone interface {
+	Create(ctx context.Context, plan types.ShardZone) error
+	Delete(ctx context.Context, state types.ShardZone) error
+	Exists(ctx context.Context, state types.ShardZone) (bool, error)
+	ImportState(ctx context.Context, id string) (types.ShardZone, error)
+}
+
+type ResourceZoneKeyRange interface {
+	Create(ctx context.Context, plan types.ZoneKeyRange) error
+	Delete(ctx context.Context, state types.ZoneKeyRange) error
+	Read(ctx context.Context, state types.ZoneKeyRange) (types.ZoneKeyRange, bool, error)
+	ImportState(ctx context.Context, id string) (types.ZoneKeyRange, error)
+}
+
+type ResourceBalancer interface {
+	Create(ctx context.Context, plan types.Balancer) error
+	Update(ctx context.Context, plan types.Balancer) error
+	Delete(ctx context.Context, state types.Balancer) error
+	Read(ctx context.Context, state types.Balancer) (types.Balancer, error)
+	ImportState(ctx context.Context) (types.Balancer, error)
+}
+
+type ResourceDefaultRWConcern interface {
+	Create(ctx context.Context, plan types.DefaultRWConcern) error
+	Update(ctx context.Context, plan types.DefaultRWConcern) error
+	Delete(ctx context.Context, state types.DefaultRWConcern) error
+	Read(ctx context.Context, state types.DefaultRWConcern) (types.DefaultRWConcern, error)
+	ImportState(ctx context.Context) (types.DefaultRWConcern, error)
+}
+
+type ResourceServerParameter interface {
+	Create(ctx context.Context, plan types.ServerParameter) (types.ServerParameter, error)
+	Update(ctx context.Context, plan types.ServerParameter) error
+	Delete(ctx context.Context, state types.ServerParameter) error
+	Read(ctx context.Context, state types.ServerParameter) (types.ServerParameter, error)
+	ImportState(ctx context.Context, id string) (types.ServerParameter, error)
+}
+
+type ResourceClusterParameter interface {
+	Create(ctx context.Context, plan types.ClusterParameter) (types.ClusterParameter, error)
+	Update(ctx context.Context, plan types.ClusterParameter) error
+	Delete(ctx context.Context, state types.ClusterParameter) error
+	Read(ctx context.Context, state types.ClusterParameter) (types.ClusterParameter, error)
+	ImportState(ctx context.Context, name string) (types.ClusterParameter, error)
+}
+
+type ResourceFeatureCompatibilityVersion interface {
+	Create(ctx context.Context, plan types.FeatureCompatibilityVersion) error
+	Update(ctx context.Context, plan types.FeatureCompatibilityVersion) error
+	Delete(ctx context.Context, state types.FeatureCompatibilityVersion) error
+	Read(ctx context.Context, state types.FeatureCompatibilityVersion) (types.FeatureCompatibilityVersion, error)
+	ImportState(ctx context.Context) (types.FeatureCompatibilityVersion, error)
+}
+
+type ResourceProfiler interface {
+	Create(ctx context.Context, plan types.Profiler) error
+	Update(ctx context.Context, plan types.Profiler) error
+	Delete(ctx context.Context, state types.Profiler) error
+	Read(ctx context.Context, state types.Profiler) (types.Profiler, error)
+	ImportState(ctx context.Context, database string) (types.Profiler, error)
+}
+
+type ResourceView interface {
+	Create(ctx context.Context, plan types.View) error
+	Update(ctx context.Context, plan types.View) error
+	Delete(ctx context.Context, state types.View) error
+	Read(ctx context.Context, state types.View) (types.View, bool, error)
+	ImportState(ctx context.Context, namespace string) (types.View, error)
+}
+
+type ResourceTimeSeriesCollection interface {
+	Create(ctx context.Context, plan types.TimeSeriesCollection) error
+	Update(ctx context.Context, plan types.TimeSeriesCollection) error
+	Delete(ctx context.Context, state types.TimeSeriesCollection) error
+	Read(ctx context.Context, state types.TimeSeriesCollection) (types.TimeSeriesCollection, bool, error)
+	ImportState(ctx context.Context, namespace string) (types.TimeSeriesCollection, error)
+}
+
+type ResourceClusteredCollection interface {
+	Create(ctx context.Context, plan types.ClusteredCollection) error
+	Update(ctx context.Context, plan types.ClusteredCollection) error
+	Delete(ctx context.Context, state types.ClusteredCollection) error
+	Read(ctx context.Context, state types.ClusteredCollection) (types.ClusteredCollection, bool, error)
+	ImportState(ctx context.Context, namespace string) (types.ClusteredCollection, error)
+}
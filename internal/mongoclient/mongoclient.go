@@ -1,47 +1,35 @@
 package mongoclient
 
 import (
-	"time"
-
+	"terraform-provider-mongodb/internal/mongoclient/executor"
 	"terraform-provider-mongodb/internal/mongoclient/interfaces"
 	"terraform-provider-mongodb/internal/mongoclient/mongodb"
 	"terraform-provider-mongodb/internal/mongoclient/types"
 )
 
 type client struct {
-	uri           string
-	retryAttempts uint
-	retryDelay    time.Duration
+	executor *executor.Executor
 }
 
-func New(uri string, retryAttempts, retryDelay uint) interfaces.Client {
-	// Validate the retry parameters
-	if retryAttempts == 0 {
-		retryAttempts = types.RetryAttempts
-	}
-
-	if retryDelay == 0 {
-		retryDelay = types.RetryDelaySec
-	}
-
+// New creates a client with the provider retry policy. Unset fields of retry fall back to the defaults.
+// If traceFile is not empty, driver events of every operation are appended to it as JSON lines.
+func New(uri string, retry *types.Retry, traceFile string) interfaces.Client {
 	return &client{
-		uri:           uri,
-		retryAttempts: retryAttempts,
-		retryDelay:    time.Duration(retryDelay) * time.Second,
+		executor: &executor.Executor{
+			Uri:       uri,
+			Policy:    executor.DefaultPolicy().Merge(retry),
+			TraceFile: traceFile,
+		},
 	}
 }
 
 func (c *client) DataSource() interfaces.DataSource {
 	return &mongodb.DataSource{
-		Uri:           c.uri,
-		RetryAttempts: c.retryAttempts,
-		RetryDelay:    c.retryDelay,
+		Executor: c.executor,
 	}
 }
 func (c *client) Resource() interfaces.Resource {
 	return &mongodb.Resource{
-		Uri:           c.uri,
-		RetryAttempts: c.retryAttempts,
-		RetryDelay:    c.retryDelay,
+		Executor: c.executor,
 	}
 }
@@ -0,0 +1,272 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
+)
+
+// Documents of config.settings with the balancer settings.
+const (
+	balancerSettingsID  = "balancer"
+	chunkSizeSettingsID = "chunksize"
+)
+
+func (r *ResourceBalancer) Create(ctx context.Context, plan types.Balancer) error {
+	op := executor.Operation{Name: "create balancer"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		return applyBalancer(ctx, c, plan)
+	})
+}
+
+func (r *ResourceBalancer) Update(ctx context.Context, plan types.Balancer) error {
+	op := executor.Operation{Name: "update balancer"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		return applyBalancer(ctx, c, plan)
+	})
+}
+
+// Delete restores the MongoDB defaults: the balancer runs all day with the default chunk size
+// and balances every collection.
+func (r *ResourceBalancer) Delete(ctx context.Context, state types.Balancer) error {
+	op := executor.Operation{Name: "delete balancer"}
+
+	return r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		return applyBalancer(ctx, c, types.Balancer{Enabled: true})
+	})
+}
+
+// Read returns the current balancer configuration. Empty disabled collections are kept unset
+// when they are unset in the state.
+func (r *ResourceBalancer) Read(ctx context.Context, state types.Balancer) (types.Balancer, error) {
+	var b types.Balancer
+
+	op := executor.Operation{Name: "read balancer"}
+
+	err := r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		b, err = getBalancer(ctx, c)
+
+		return err
+	})
+
+	if err != nil {
+		return state, err
+	}
+
+	if len(b.DisabledCollections) == 0 && state.DisabledCollections == nil {
+		b.DisabledCollections = nil
+	}
+
+	b.Retry = state.Retry
+	b.Timeouts = state.Timeouts
+
+	return b, nil
+}
+
+func (r *ResourceBalancer) ImportState(ctx context.Context) (types.Balancer, error) {
+	var b types.Balancer
+
+	op := executor.Operation{Name: "import balancer"}
+
+	err := r.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		b, err = getBalancer(ctx, c)
+
+		return err
+	})
+
+	if err != nil {
+		return types.Balancer{}, err
+	}
+
+	if len(b.DisabledCollections) == 0 {
+		b.DisabledCollections = nil
+	}
+
+	return b, nil
+}
+
+// applyBalancer writes the settings to config.settings and config.collections like the sh helpers of mongosh,
+// and starts or stops the balancer last, so it does not run before its window is set.
+func applyBalancer(ctx context.Context, client *mongo.Client, b types.Balancer) error {
+	config := client.Database(types.ConfigDatabase, options.Database().SetWriteConcern(writeconcern.Majority()))
+	settings := config.Collection("settings")
+
+	window := bson.D{{Key: "$unset", Value: bson.D{{Key: "activeWindow", Value: ""}}}}
+	if b.ActiveWindow != nil {
+		window = bson.D{{Key: "$set", Value: bson.D{{Key: "activeWindow", Value: b.ActiveWindow}}}}
+	}
+
+	_, err := settings.UpdateOne(ctx, bson.D{{Key: "_id", Value: balancerSettingsID}}, window, options.UpdateOne().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to set balancer window: %w", err)
+	}
+
+	if b.ChunkSizeMB != nil {
+		_, err = settings.UpdateOne(
+			ctx,
+			bson.D{{Key: "_id", Value: chunkSizeSettingsID}},
+			bson.D{{Key: "$set", Value: bson.D{{Key: "value", Value: *b.ChunkSizeMB}}}},
+			options.UpdateOne().SetUpsert(true),
+		)
+	} else {
+		_, err = settings.DeleteOne(ctx, bson.D{{Key: "_id", Value: chunkSizeSettingsID}})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set chunk size: %w", err)
+	}
+
+	disabled, err := listBalancingDisabled(ctx, client)
+	if err != nil {
+		return fmt.Errorf("failed to list collections with disabled balancing: %w", err)
+	}
+
+	for _, ns := range b.DisabledCollections {
+		if !slices.Contains(disabled, ns) {
+			if err := setCollectionBalancing(ctx, config, ns, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, ns := range disabled {
+		if !slices.Contains(b.DisabledCollections, ns) {
+			if err := setCollectionBalancing(ctx, config, ns, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	command := "balancerStop"
+	if b.Enabled {
+		command = "balancerStart"
+	}
+
+	err = client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{{Key: command, Value: 1}}).Err()
+	if err != nil {
+		return fmt.Errorf("%s failed with error: %w", command, err)
+	}
+
+	return nil
+}
+
+// setCollectionBalancing sets the noBalance flag of the sharded collection.
+func setCollectionBalancing(ctx context.Context, config *mongo.Database, namespace string, enabled bool) error {
+	res, err := config.Collection("collections").UpdateOne(
+		ctx,
+		bson.D{{Key: "_id", Value: namespace}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "noBalance", Value: !enabled}}}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set balancing of collection %s: %w", namespace, err)
+	}
+
+	if res.MatchedCount == 0 {
+		return executor.Unrecoverable(fmt.Errorf("collection %s is not sharded", namespace))
+	}
+
+	return nil
+}
+
+// listBalancingDisabled returns the namespaces of the collections with disabled balancing.
+func listBalancingDisabled(ctx context.Context, client *mongo.Client) ([]string, error) {
+	cursor, err := client.Database(types.ConfigDatabase).Collection("collections").Find(ctx, bson.D{
+		{Key: "noBalance", Value: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var collections []shardingCollection
+	if err := cursor.All(ctx, &collections); err != nil {
+		return nil, err
+	}
+
+	namespaces := make([]string, 0, len(collections))
+	for _, c := range collections {
+		namespaces = append(namespaces, c.ID)
+	}
+
+	return namespaces, nil
+}
+
+// getBalancer returns the balancer state and its settings from config.settings and config.collections.
+func getBalancer(ctx context.Context, client *mongo.Client) (types.Balancer, error) {
+	var status struct {
+		Mode string `bson:"mode"`
+	}
+
+	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{{Key: "balancerStatus", Value: 1}}).Decode(&status)
+	if err != nil {
+		return types.Balancer{}, fmt.Errorf("failed to get balancer status: %w", err)
+	}
+
+	b := types.Balancer{Enabled: status.Mode != types.BalancerModeOff}
+	settings := client.Database(types.ConfigDatabase).Collection("settings")
+
+	var balancer struct {
+		ActiveWindow *types.BalancerWindow `bson:"activeWindow"`
+	}
+
+	err = settings.FindOne(ctx, bson.D{{Key: "_id", Value: balancerSettingsID}}).Decode(&balancer)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return types.Balancer{}, fmt.Errorf("failed to get balancer window: %w", err)
+	}
+
+	b.ActiveWindow = balancer.ActiveWindow
+
+	var chunkSize struct {
+		Value int64 `bson:"value"`
+	}
+
+	err = settings.FindOne(ctx, bson.D{{Key: "_id", Value: chunkSizeSettingsID}}).Decode(&chunkSize)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return types.Balancer{}, fmt.Errorf("failed to get chunk size: %w", err)
+	}
+
+	if err == nil {
+		b.ChunkSizeMB = &chunkSize.Value
+	}
+
+	b.DisabledCollections, err = listBalancingDisabled(ctx, client)
+	if err != nil {
+		return types.Balancer{}, fmt.Errorf("failed to list collections with disabled balancing: %w", err)
+	}
+
+	return b, nil
+}
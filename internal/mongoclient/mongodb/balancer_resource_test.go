@@ -0,0 +1,180 @@
+package mongodb
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// balancerConfig returns a find handler with the balancer settings and app.events with disabled balancing.
+func balancerConfig() mongotest.Handler {
+	return mongotest.Collections(map[string][]bson.D{
+		"config.settings": {
+			{{Key: "_id", Value: "balancer"}, {Key: "activeWindow", Value: bson.D{{Key: "start", Value: "23:00"}, {Key: "stop", Value: "06:00"}}}},
+			{{Key: "_id", Value: "chunksize"}, {Key: "value", Value: float64(64)}},
+		},
+		"config.collections": {
+			{{Key: "_id", Value: "app.events"}, {Key: "noBalance", Value: true}},
+		},
+	})
+}
+
+// updates returns the update documents of the update commands by <collection>/<_id of the filter>.
+func updates(t *testing.T, srv *mongotest.Server) map[string]bson.Raw {
+	t.Helper()
+
+	got := map[string]bson.Raw{}
+
+	for _, cmd := range srv.Commands("update") {
+		values, err := lookup(t, cmd, "updates").Array().Values()
+		if err != nil {
+			t.Fatalf("malformed updates: %s", err)
+		}
+
+		for _, v := range values {
+			u := v.Document()
+			id := lookup(t, u, "q", "_id").StringValue()
+			got[lookup(t, cmd, "update").StringValue()+"/"+id] = lookup(t, u, "u").Document()
+		}
+	}
+
+	return got
+}
+
+func TestResourceBalancerCreate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+	srv.Handle("find", balancerConfig())
+
+	r := &ResourceBalancer{Executor: newTestExecutor(srv)}
+
+	size := int64(256)
+	b := types.Balancer{
+		Enabled:             false,
+		ActiveWindow:        &types.BalancerWindow{Start: "01:00", Stop: "05:00"},
+		ChunkSizeMB:         &size,
+		DisabledCollections: []string{"app.orders"},
+	}
+
+	if err := r.Create(context.Background(), b); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := updates(t, srv)
+
+	if start := lookup(t, got["settings/balancer"], "$set", "activeWindow", "start").StringValue(); start != "01:00" {
+		t.Fatalf("expected window start 01:00, got %s", start)
+	}
+
+	if v := lookup(t, got["settings/chunksize"], "$set", "value").AsInt64(); v != size {
+		t.Fatalf("expected chunk size %d, got %d", size, v)
+	}
+
+	if !lookup(t, got["collections/app.orders"], "$set", "noBalance").Boolean() {
+		t.Fatal("expected balancing disabled for app.orders")
+	}
+
+	if lookup(t, got["collections/app.events"], "$set", "noBalance").Boolean() {
+		t.Fatal("expected balancing enabled for app.events")
+	}
+
+	if srv.Calls("balancerStop") != 1 || srv.Calls("balancerStart") != 0 {
+		t.Fatalf("expected balancer stopped, got %d stops and %d starts", srv.Calls("balancerStop"), srv.Calls("balancerStart"))
+	}
+}
+
+func TestResourceBalancerDeleteRestoresDefaults(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+	srv.Handle("find", balancerConfig())
+
+	r := &ResourceBalancer{Executor: newTestExecutor(srv)}
+
+	if err := r.Delete(context.Background(), types.Balancer{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := updates(t, srv)
+
+	if _, err := got["settings/balancer"].LookupErr("$unset", "activeWindow"); err != nil {
+		t.Fatal("expected balancer window unset")
+	}
+
+	if srv.Calls("delete") != 1 {
+		t.Fatalf("expected chunk size setting deleted, got %d deletes", srv.Calls("delete"))
+	}
+
+	if lookup(t, got["collections/app.events"], "$set", "noBalance").Boolean() {
+		t.Fatal("expected balancing enabled for app.events")
+	}
+
+	if srv.Calls("balancerStart") != 1 {
+		t.Fatal("expected balancer started")
+	}
+}
+
+func TestResourceBalancerDisabledCollectionNotSharded(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+	srv.Handle("find", balancerConfig())
+	srv.Handle("update", mongotest.Reply(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}))
+
+	r := &ResourceBalancer{Executor: newTestExecutor(srv)}
+
+	err := r.Update(context.Background(), types.Balancer{Enabled: true, DisabledCollections: []string{"app.orders", "app.events"}})
+	if err == nil || !strings.Contains(err.Error(), "app.orders is not sharded") {
+		t.Fatalf("expected not sharded error, got %v", err)
+	}
+}
+
+func TestResourceBalancerRead(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+	srv.Handle("find", balancerConfig())
+	srv.Handle("balancerStatus", mongotest.Reply(bson.E{Key: "mode", Value: "off"}))
+
+	r := &ResourceBalancer{Executor: newTestExecutor(srv)}
+
+	got, err := r.Read(context.Background(), types.Balancer{Enabled: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Enabled {
+		t.Fatal("expected stopped balancer")
+	}
+
+	if got.ActiveWindow == nil || *got.ActiveWindow != (types.BalancerWindow{Start: "23:00", Stop: "06:00"}) {
+		t.Fatalf("expected window 23:00-06:00, got %v", got.ActiveWindow)
+	}
+
+	if got.ChunkSizeMB == nil || *got.ChunkSizeMB != 64 {
+		t.Fatalf("expected chunk size 64, got %v", got.ChunkSizeMB)
+	}
+
+	if !slices.Equal(got.DisabledCollections, []string{"app.events"}) {
+		t.Fatalf("expected balancing disabled for app.events, got %v", got.DisabledCollections)
+	}
+}
+
+func TestResourceBalancerReadDefaults(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+
+	r := &ResourceBalancer{Executor: newTestExecutor(srv)}
+
+	got, err := r.Read(context.Background(), types.Balancer{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !got.Enabled || got.ActiveWindow != nil || got.ChunkSizeMB != nil || got.DisabledCollections != nil {
+		t.Fatalf("expected defaults, got %+v", got)
+	}
+}
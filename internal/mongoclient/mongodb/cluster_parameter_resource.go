@@ -0,0 +1,215 @@
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// clusterParameterFields are the fields getClusterParameter adds to the value of a parameter.
+var clusterParameterFields = map[string]bool{"_id": true, "clusterParameterTime": true}
+
+// Create sets the parameter and returns it with the previous value. The previous value is read before setting it,
+// so a retried setClusterParameter does not overwrite it.
+func (r *ResourceClusterParameter) Create(ctx context.Context, plan types.ClusterParameter) (types.ClusterParameter, error) {
+	value, err := parseClusterParameterValue(plan.Value)
+	if err != nil {
+		return plan, executor.Unrecoverable(err)
+	}
+
+	op := executor.Operation{Name: "create cluster parameter"}
+
+	err = r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		if plan.PreviousValue == nil {
+			previous, err := getClusterParameter(ctx, c, plan.Name)
+			if err != nil {
+				return err
+			}
+
+			plan.PreviousValue = &previous
+		}
+
+		return setClusterParameter(ctx, c, plan.Name, value)
+	})
+
+	return plan, err
+}
+
+func (r *ResourceClusterParameter) Update(ctx context.Context, plan types.ClusterParameter) error {
+	value, err := parseClusterParameterValue(plan.Value)
+	if err != nil {
+		return executor.Unrecoverable(err)
+	}
+
+	op := executor.Operation{Name: "update cluster parameter"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		return setClusterParameter(ctx, c, plan.Name, value)
+	})
+}
+
+// Delete restores the previous value, as cluster parameters can't be unset.
+func (r *ResourceClusterParameter) Delete(ctx context.Context, state types.ClusterParameter) error {
+	if state.PreviousValue == nil {
+		return nil
+	}
+
+	previous, err := parseClusterParameterValue(*state.PreviousValue)
+	if err != nil {
+		return executor.Unrecoverable(err)
+	}
+
+	op := executor.Operation{Name: "delete cluster parameter"}
+
+	return r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		return setClusterParameter(ctx, c, state.Name, previous)
+	})
+}
+
+// Read returns the parameter with its current value when it differs from the state. Fields the server reports
+// but the state doesn't set, e.g. defaults of nested options, are not drift.
+func (r *ResourceClusterParameter) Read(ctx context.Context, state types.ClusterParameter) (types.ClusterParameter, error) {
+	var current string
+
+	op := executor.Operation{Name: "read cluster parameter"}
+
+	err := r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		var err error
+
+		current, err = getClusterParameter(ctx, c, state.Name)
+
+		return err
+	})
+	if err != nil {
+		return state, err
+	}
+
+	if !containsClusterParameterValue(current, state.Value) {
+		state.Value = current
+	}
+
+	return state, nil
+}
+
+// ImportState imports the parameter by name. The current value is taken as the previous value,
+// so deleting the imported resource keeps it.
+func (r *ResourceClusterParameter) ImportState(ctx context.Context, name string) (types.ClusterParameter, error) {
+	var current string
+
+	op := executor.Operation{Name: "import cluster parameter"}
+
+	err := r.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		var err error
+
+		current, err = getClusterParameter(ctx, c, name)
+
+		return err
+	})
+	if err != nil {
+		return types.ClusterParameter{}, err
+	}
+
+	return types.ClusterParameter{Name: name, Value: current, PreviousValue: &current}, nil
+}
+
+// setClusterParameter sets the value of the cluster parameter.
+func setClusterParameter(ctx context.Context, client *mongo.Client, name string, value bson.Raw) error {
+	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+		{Key: "setClusterParameter", Value: bson.D{{Key: name, Value: value}}},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("setClusterParameter failed with error: %w", err)
+	}
+
+	return nil
+}
+
+// getClusterParameter returns the value of the cluster parameter in relaxed Extended JSON,
+// without the fields added by the server.
+func getClusterParameter(ctx context.Context, client *mongo.Client, name string) (string, error) {
+	reply := struct {
+		ClusterParameters []bson.Raw `bson:"clusterParameters"`
+	}{}
+
+	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+		{Key: "getClusterParameter", Value: name},
+	}).Decode(&reply)
+	if err != nil {
+		return "", fmt.Errorf("getClusterParameter failed with error: %w", err)
+	}
+
+	if len(reply.ClusterParameters) == 0 {
+		return "", executor.Unrecoverable(fmt.Errorf("cluster parameter %s not found", name))
+	}
+
+	elements, err := reply.ClusterParameters[0].Elements()
+	if err != nil {
+		return "", err
+	}
+
+	value := bson.D{}
+	for _, e := range elements {
+		if !clusterParameterFields[e.Key()] {
+			value = append(value, bson.E{Key: e.Key(), Value: e.Value()})
+		}
+	}
+
+	b, err := bson.MarshalExtJSON(value, false, false)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// parseClusterParameterValue parses a document in Extended JSON, e.g. {"preAndPostImages": {"expireAfterSeconds": 100}}.
+func parseClusterParameterValue(value string) (bson.Raw, error) {
+	var d bson.Raw
+
+	err := bson.UnmarshalExtJSON([]byte(value), false, &d)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a JSON object: %w", value, err)
+	}
+
+	return d, nil
+}
+
+// containsClusterParameterValue checks if the current value has every field of the desired value. Values are compared
+// as JSON, so numbers of different types with the same value are equal.
+func containsClusterParameterValue(current, desired string) bool {
+	var currentValue, desiredValue interface{}
+
+	if json.Unmarshal([]byte(current), &currentValue) != nil || json.Unmarshal([]byte(desired), &desiredValue) != nil {
+		return current == desired
+	}
+
+	return containsJSON(currentValue, desiredValue)
+}
+
+// containsJSON checks if the current value matches the desired one, ignoring object fields the desired value doesn't set.
+func containsJSON(current, desired interface{}) bool {
+	desiredObject, ok := desired.(map[string]interface{})
+	if !ok {
+		return reflect.DeepEqual(current, desired)
+	}
+
+	currentObject, ok := current.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	for k, v := range desiredObject {
+		if !containsJSON(currentObject[k], v) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,163 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// changeStreamOptions returns a getClusterParameter handler reporting the changeStreamOptions parameter.
+func changeStreamOptions(expireAfterSeconds interface{}) mongotest.Handler {
+	return mongotest.Reply(bson.E{Key: "clusterParameters", Value: bson.A{bson.D{
+		{Key: "_id", Value: "changeStreamOptions"},
+		{Key: "clusterParameterTime", Value: bson.Timestamp{T: 1, I: 1}},
+		{Key: "preAndPostImages", Value: bson.D{{Key: "expireAfterSeconds", Value: expireAfterSeconds}}},
+	}}})
+}
+
+func TestResourceClusterParameterCreate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+	srv.Handle("getClusterParameter", changeStreamOptions("off"))
+
+	r := &ResourceClusterParameter{Executor: newTestExecutor(srv)}
+
+	plan := types.ClusterParameter{Name: "changeStreamOptions", Value: `{"preAndPostImages":{"expireAfterSeconds":100}}`}
+
+	got, err := r.Create(context.Background(), plan)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"preAndPostImages":{"expireAfterSeconds":"off"}}`
+	if got.PreviousValue == nil || *got.PreviousValue != want {
+		t.Fatalf("expected previous value %s, got %v", want, got.PreviousValue)
+	}
+
+	v := lookup(t, srv.Commands("setClusterParameter")[0], "setClusterParameter", "changeStreamOptions", "preAndPostImages", "expireAfterSeconds")
+	if v.Type != bson.TypeInt32 || v.Int32() != 100 {
+		t.Fatalf("expected int32 expireAfterSeconds 100, got %s", v)
+	}
+}
+
+func TestResourceClusterParameterCreateUnknown(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceClusterParameter{Executor: newTestExecutor(srv)}
+
+	_, err := r.Create(context.Background(), types.ClusterParameter{Name: "noSuchParameter", Value: `{}`})
+	if err == nil || !strings.Contains(err.Error(), "NoSuchKey") {
+		t.Fatalf("expected NoSuchKey error, got %v", err)
+	}
+
+	if srv.Calls("getClusterParameter") != 1 || srv.Calls("setClusterParameter") != 0 {
+		t.Fatal("expected a single getClusterParameter without setClusterParameter")
+	}
+}
+
+func TestResourceClusterParameterCreateInvalidValue(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceClusterParameter{Executor: newTestExecutor(srv)}
+
+	_, err := r.Create(context.Background(), types.ClusterParameter{Name: "changeStreamOptions", Value: `100`})
+	if err == nil || !strings.Contains(err.Error(), "not a JSON object") {
+		t.Fatalf("expected invalid value error, got %v", err)
+	}
+
+	if srv.Calls("getClusterParameter") != 0 {
+		t.Fatal("expected no getClusterParameter")
+	}
+}
+
+func TestResourceClusterParameterRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		current interface{}
+		state   string
+		want    string
+	}{
+		{
+			name:    "same value",
+			current: int32(100),
+			state:   `{"preAndPostImages":{"expireAfterSeconds":100}}`,
+			want:    `{"preAndPostImages":{"expireAfterSeconds":100}}`,
+		},
+		{
+			name:    "same number of another type",
+			current: int64(100),
+			state:   `{"preAndPostImages":{"expireAfterSeconds":100.0}}`,
+			want:    `{"preAndPostImages":{"expireAfterSeconds":100.0}}`,
+		},
+		{
+			name:    "fields unset in the state",
+			current: "off",
+			state:   `{}`,
+			want:    `{}`,
+		},
+		{
+			name:    "drift",
+			current: "off",
+			state:   `{"preAndPostImages":{"expireAfterSeconds":100}}`,
+			want:    `{"preAndPostImages":{"expireAfterSeconds":"off"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.Handle("getClusterParameter", changeStreamOptions(tt.current))
+
+			r := &ResourceClusterParameter{Executor: newTestExecutor(srv)}
+
+			got, err := r.Read(context.Background(), types.ClusterParameter{Name: "changeStreamOptions", Value: tt.state})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.Value != tt.want {
+				t.Fatalf("expected value %s, got %s", tt.want, got.Value)
+			}
+		})
+	}
+}
+
+func TestResourceClusterParameterDeleteRestoresPrevious(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceClusterParameter{Executor: newTestExecutor(srv)}
+
+	previous := `{"preAndPostImages":{"expireAfterSeconds":"off"}}`
+	state := types.ClusterParameter{Name: "changeStreamOptions", Value: `{"preAndPostImages":{"expireAfterSeconds":100}}`, PreviousValue: &previous}
+
+	if err := r.Delete(context.Background(), state); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	v := lookup(t, srv.Commands("setClusterParameter")[0], "setClusterParameter", "changeStreamOptions", "preAndPostImages", "expireAfterSeconds")
+	if v.StringValue() != "off" {
+		t.Fatalf("expected expireAfterSeconds restored to off, got %s", v)
+	}
+}
+
+func TestResourceClusterParameterImportState(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("getClusterParameter", changeStreamOptions(int32(100)))
+
+	r := &ResourceClusterParameter{Executor: newTestExecutor(srv)}
+
+	got, err := r.ImportState(context.Background(), "changeStreamOptions")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"preAndPostImages":{"expireAfterSeconds":100}}`
+	if got.Value != want || got.PreviousValue == nil || *got.PreviousValue != want {
+		t.Fatalf("expected value %s, got %+v", want, got)
+	}
+}
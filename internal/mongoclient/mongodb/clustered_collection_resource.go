@@ -0,0 +1,173 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// clusteredCollectionOptions are the options of a collection reported by listCollections.
+// ClusteredIndex is nil for a collection which is not clustered.
+type clusteredCollectionOptions struct {
+	ClusteredIndex *struct {
+		Name string `bson:"name"`
+	} `bson:"clusteredIndex"`
+	ExpireAfterSeconds *int64 `bson:"expireAfterSeconds"`
+}
+
+func (r *ResourceClusteredCollection) Create(ctx context.Context, plan types.ClusteredCollection) error {
+	index := bson.D{
+		{Key: "key", Value: bson.D{{Key: "_id", Value: 1}}},
+		{Key: "unique", Value: true},
+	}
+
+	if plan.IndexName != nil {
+		index = append(index, bson.E{Key: "name", Value: *plan.IndexName})
+	}
+
+	cmd := bson.D{
+		{Key: "create", Value: plan.Name},
+		{Key: "clusteredIndex", Value: index},
+	}
+
+	if plan.ExpireAfterSeconds != nil {
+		cmd = append(cmd, bson.E{Key: "expireAfterSeconds", Value: *plan.ExpireAfterSeconds})
+	}
+
+	op := executor.Operation{Name: "create clustered collection"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireServerVersion(ctx, c, 5, 3, "clustered collections")
+		if err != nil {
+			return err
+		}
+
+		err = c.Database(plan.Database).RunCommand(ctx, cmd).Err()
+		if err != nil {
+			return fmt.Errorf("create clustered collection failed with error: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// Update changes the expiration of the collection in place with collMod, the clustered index cannot be changed.
+func (r *ResourceClusteredCollection) Update(ctx context.Context, plan types.ClusteredCollection) error {
+	var expireAfterSeconds interface{} = "off"
+	if plan.ExpireAfterSeconds != nil {
+		expireAfterSeconds = *plan.ExpireAfterSeconds
+	}
+
+	op := executor.Operation{Name: "update clustered collection"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := c.Database(plan.Database).RunCommand(ctx, bson.D{
+			{Key: "collMod", Value: plan.Name},
+			{Key: "expireAfterSeconds", Value: expireAfterSeconds},
+		}).Err()
+		if err != nil {
+			return fmt.Errorf("collMod failed with error: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *ResourceClusteredCollection) Delete(ctx context.Context, state types.ClusteredCollection) error {
+	op := executor.Operation{Name: "delete clustered collection"}
+
+	return r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		return dropCollection(ctx, c, state.Database, state.Name)
+	})
+}
+
+// Read returns the definition of the collection from listCollections. The default index name is kept unset
+// if it is unset in the state. It returns false if the collection does not exist.
+func (r *ResourceClusteredCollection) Read(ctx context.Context, state types.ClusteredCollection) (types.ClusteredCollection, bool, error) {
+	var current *types.ClusteredCollection
+
+	op := executor.Operation{Name: "read clustered collection"}
+
+	err := r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		var err error
+
+		current, err = getClusteredCollection(ctx, c, state.Database, state.Name)
+
+		return err
+	})
+	if err != nil {
+		return state, false, err
+	}
+
+	if current == nil {
+		return state, false, nil
+	}
+
+	state.ExpireAfterSeconds = current.ExpireAfterSeconds
+
+	if state.IndexName != nil || *current.IndexName != types.ClusteredCollectionDefaultIndexName {
+		state.IndexName = current.IndexName
+	}
+
+	return state, true, nil
+}
+
+// ImportState imports the collection by the <database>.<name> namespace. The default index name is left unset.
+func (r *ResourceClusteredCollection) ImportState(ctx context.Context, namespace string) (types.ClusteredCollection, error) {
+	var current *types.ClusteredCollection
+
+	db, name, ok := splitNamespace(namespace)
+	if !ok {
+		return types.ClusteredCollection{}, fmt.Errorf("invalid import ID %q, expected <database>.<collection>", namespace)
+	}
+
+	op := executor.Operation{Name: "import clustered collection"}
+
+	err := r.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		var err error
+
+		current, err = getClusteredCollection(ctx, c, db, name)
+
+		return err
+	})
+	if err != nil {
+		return types.ClusteredCollection{}, err
+	}
+
+	if current == nil {
+		return types.ClusteredCollection{}, fmt.Errorf("clustered collection %s does not exist", namespace)
+	}
+
+	if *current.IndexName == types.ClusteredCollectionDefaultIndexName {
+		current.IndexName = nil
+	}
+
+	return *current, nil
+}
+
+// getClusteredCollection returns the collection from listCollections, or nil if there is no collection
+// or view with the name. The index name is always set.
+func getClusteredCollection(ctx context.Context, client *mongo.Client, database, name string) (*types.ClusteredCollection, error) {
+	var opts clusteredCollectionOptions
+
+	found, err := getCollectionOptions(ctx, client, database, name, collectionTypeCollection, &opts)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	if opts.ClusteredIndex == nil {
+		return nil, executor.Unrecoverable(fmt.Errorf("%s.%s is not a clustered collection", database, name))
+	}
+
+	return &types.ClusteredCollection{
+		Database:           database,
+		Name:               name,
+		IndexName:          &opts.ClusteredIndex.Name,
+		ExpireAfterSeconds: opts.ExpireAfterSeconds,
+	}, nil
+}
@@ -0,0 +1,224 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// sessionsCollection is the listCollections entry of a clustered collection of sessions.
+func sessionsCollection(indexName string) bson.D {
+	return bson.D{
+		{Key: "name", Value: "sessions"},
+		{Key: "type", Value: "collection"},
+		{Key: "options", Value: bson.D{
+			{Key: "clusteredIndex", Value: bson.D{
+				{Key: "v", Value: 2},
+				{Key: "key", Value: bson.D{{Key: "_id", Value: 1}}},
+				{Key: "name", Value: indexName},
+				{Key: "unique", Value: true},
+			}},
+			{Key: "expireAfterSeconds", Value: int64(3600)},
+		}},
+		{Key: "info", Value: bson.D{{Key: "readOnly", Value: false}}},
+	}
+}
+
+func TestResourceClusteredCollectionCreate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceClusteredCollection{Executor: newTestExecutor(srv)}
+
+	name, expire := "sessions_by_id", int64(3600)
+	plan := types.ClusteredCollection{Database: "app", Name: "sessions", IndexName: &name, ExpireAfterSeconds: &expire}
+
+	if err := r.Create(context.Background(), plan); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmd := srv.Commands("create")[0]
+
+	if db := lookup(t, cmd, "$db").StringValue(); db != "app" {
+		t.Fatalf("expected create on app, got %s", db)
+	}
+
+	if v := lookup(t, cmd, "clusteredIndex", "key", "_id").AsInt64(); v != 1 {
+		t.Fatalf("expected clustered index key {_id: 1}, got %d", v)
+	}
+
+	if !lookup(t, cmd, "clusteredIndex", "unique").Boolean() {
+		t.Fatal("expected a unique clustered index")
+	}
+
+	if v := lookup(t, cmd, "clusteredIndex", "name").StringValue(); v != "sessions_by_id" {
+		t.Fatalf("expected index name sessions_by_id, got %s", v)
+	}
+
+	if v := lookup(t, cmd, "expireAfterSeconds").Int64(); v != 3600 {
+		t.Fatalf("expected expireAfterSeconds 3600, got %d", v)
+	}
+}
+
+func TestResourceClusteredCollectionCreateUnsupportedVersion(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("buildInfo", mongotest.Reply(bson.E{Key: "versionArray", Value: bson.A{5, 0, 14, 0}}))
+
+	r := &ResourceClusteredCollection{Executor: newTestExecutor(srv)}
+
+	err := r.Create(context.Background(), types.ClusteredCollection{Database: "app", Name: "sessions"})
+	if err == nil || !strings.Contains(err.Error(), "clustered collections requires MongoDB 5.3 or later") {
+		t.Fatalf("expected version error, got %v", err)
+	}
+
+	if srv.Calls("create") != 0 {
+		t.Fatal("expected no create")
+	}
+}
+
+func TestResourceClusteredCollectionUpdate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceClusteredCollection{Executor: newTestExecutor(srv)}
+
+	expire := int64(60)
+
+	if err := r.Update(context.Background(), types.ClusteredCollection{Database: "app", Name: "sessions", ExpireAfterSeconds: &expire}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := r.Update(context.Background(), types.ClusteredCollection{Database: "app", Name: "sessions"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmds := srv.Commands("collMod")
+
+	if v := lookup(t, cmds[0], "expireAfterSeconds").Int64(); v != 60 {
+		t.Fatalf("expected expireAfterSeconds 60, got %d", v)
+	}
+
+	if v := lookup(t, cmds[1], "expireAfterSeconds").StringValue(); v != "off" {
+		t.Fatalf("expected expiration off, got %s", v)
+	}
+}
+
+func TestResourceClusteredCollectionDelete(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceClusteredCollection{Executor: newTestExecutor(srv)}
+
+	if err := r.Delete(context.Background(), types.ClusteredCollection{Database: "app", Name: "sessions"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if v := lookup(t, srv.Commands("drop")[0], "drop").StringValue(); v != "sessions" {
+		t.Fatalf("expected drop of sessions, got %s", v)
+	}
+}
+
+func TestResourceClusteredCollectionRead(t *testing.T) {
+	defaultName := types.ClusteredCollectionDefaultIndexName
+
+	tests := []struct {
+		name          string
+		collections   []bson.D
+		stateIndex    *string
+		wantExist     bool
+		wantIndexName string
+		wantErr       string
+	}{
+		{
+			name:        "default index name",
+			collections: []bson.D{sessionsCollection("_id_")},
+			wantExist:   true,
+		},
+		{
+			name:          "default index name in state",
+			collections:   []bson.D{sessionsCollection("_id_")},
+			stateIndex:    &defaultName,
+			wantExist:     true,
+			wantIndexName: "_id_",
+		},
+		{
+			name:          "custom index name",
+			collections:   []bson.D{sessionsCollection("sessions_by_id")},
+			wantExist:     true,
+			wantIndexName: "sessions_by_id",
+		},
+		{
+			name: "collection dropped",
+		},
+		{
+			name:        "not clustered",
+			collections: []bson.D{{{Key: "name", Value: "sessions"}, {Key: "type", Value: "collection"}, {Key: "options", Value: bson.D{}}}},
+			wantErr:     "is not a clustered collection",
+		},
+		{
+			name:        "time-series collection",
+			collections: []bson.D{{{Key: "name", Value: "sessions"}, {Key: "type", Value: "timeseries"}, {Key: "options", Value: bson.D{}}}},
+			wantErr:     "is a timeseries, not a collection",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.Handle("listCollections", mongotest.Cursor(tt.collections...))
+
+			r := &ResourceClusteredCollection{Executor: newTestExecutor(srv)}
+
+			got, exist, err := r.Read(context.Background(), types.ClusteredCollection{Database: "app", Name: "sessions", IndexName: tt.stateIndex})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if exist != tt.wantExist {
+				t.Fatalf("expected exist %t, got %t", tt.wantExist, exist)
+			}
+
+			if !exist {
+				return
+			}
+
+			if got.ExpireAfterSeconds == nil || *got.ExpireAfterSeconds != 3600 {
+				t.Fatalf("expected expiration after 3600 seconds, got %v", got.ExpireAfterSeconds)
+			}
+
+			if (tt.wantIndexName == "") != (got.IndexName == nil) || got.IndexName != nil && *got.IndexName != tt.wantIndexName {
+				t.Fatalf("expected index name %q, got %v", tt.wantIndexName, got.IndexName)
+			}
+		})
+	}
+}
+
+func TestResourceClusteredCollectionImportState(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("listCollections", mongotest.Cursor(sessionsCollection("_id_")))
+
+	r := &ResourceClusteredCollection{Executor: newTestExecutor(srv)}
+
+	got, err := r.ImportState(context.Background(), "app.sessions")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Database != "app" || got.Name != "sessions" || got.IndexName != nil || got.ExpireAfterSeconds == nil {
+		t.Fatalf("expected sessions collection with the default index name and an expiration, got %+v", got)
+	}
+
+	if _, err := r.ImportState(context.Background(), "sessions"); err == nil {
+		t.Fatal("expected invalid import ID error")
+	}
+}
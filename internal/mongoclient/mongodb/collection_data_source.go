@@ -0,0 +1,111 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Read lists the collections and views of the database without the system collections, filtered by
+// the name regex on the server. With statistics, the collections are completed with $collStats, views have none.
+func (d *DataSourceCollection) Read(ctx context.Context, config types.Collections) (types.Collections, error) {
+	filter := bson.D{}
+	if config.NameRegex != nil {
+		filter = append(filter, bson.E{Key: "name", Value: bson.D{{Key: "$regex", Value: *config.NameRegex}}})
+	}
+
+	op := executor.Operation{Name: "read collections"}
+
+	err := d.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		db := c.Database(config.Database)
+		config.Collections = []types.Collection{}
+
+		specs, err := db.ListCollectionSpecifications(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("listCollections failed with error: %w", err)
+		}
+
+		for _, spec := range specs {
+			if strings.HasPrefix(spec.Name, "system.") {
+				continue
+			}
+
+			collection, err := collectionFromSpecification(spec)
+			if err != nil {
+				return executor.Unrecoverable(fmt.Errorf("failed to decode the options of %s.%s: %w", config.Database, spec.Name, err))
+			}
+
+			if config.WithStatistics() && spec.Type != collectionTypeView {
+				stats, err := getCollectionStats(ctx, db, spec.Name)
+				if err != nil {
+					return err
+				}
+
+				collection.Stats = &types.CollectionStats{
+					Count:          stats.Count,
+					Size:           stats.Size,
+					StorageSize:    stats.StorageSize,
+					TotalIndexSize: stats.TotalIndexSize,
+					Indexes:        stats.Indexes,
+				}
+			}
+
+			config.Collections = append(config.Collections, collection)
+		}
+
+		sort.Slice(config.Collections, func(i, j int) bool {
+			return config.Collections[i].Name < config.Collections[j].Name
+		})
+
+		return nil
+	})
+
+	return config, err
+}
+
+// collectionFromSpecification returns the collection of the listCollections specification,
+// with the validator split from the other options.
+func collectionFromSpecification(spec mongo.CollectionSpecification) (types.Collection, error) {
+	collection := types.Collection{Name: spec.Name, Type: spec.Type}
+
+	options := bson.D{}
+	if len(spec.Options) > 0 {
+		err := bson.Unmarshal(spec.Options, &options)
+		if err != nil {
+			return collection, err
+		}
+	}
+
+	i := slices.IndexFunc(options, func(e bson.E) bool { return e.Key == "validator" })
+	if i >= 0 {
+		validator, ok := options[i].Value.(bson.D)
+		if !ok {
+			return collection, fmt.Errorf("validator is not a document")
+		}
+
+		formatted, err := formatDocument(validator)
+		if err != nil {
+			return collection, err
+		}
+
+		collection.Validator = &formatted
+		options = slices.Delete(options, i, i+1)
+	}
+
+	formatted, err := formatDocument(options)
+	if err != nil {
+		return collection, err
+	}
+
+	collection.Options = formatted
+
+	return collection, nil
+}
@@ -0,0 +1,86 @@
+package mongodb
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestDataSourceCollectionRead(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("listCollections", mongotest.Cursor(
+		bson.D{{Key: "name", Value: "orders"}, {Key: "type", Value: "collection"}, {Key: "options", Value: bson.D{
+			{Key: "validator", Value: bson.D{{Key: "total", Value: bson.D{{Key: "$gte", Value: 0}}}}},
+			{Key: "validationLevel", Value: "strict"},
+		}}},
+		bson.D{{Key: "name", Value: "active_orders"}, {Key: "type", Value: "view"}, {Key: "options", Value: bson.D{
+			{Key: "viewOn", Value: "orders"},
+			{Key: "pipeline", Value: bson.A{}},
+		}}},
+		bson.D{{Key: "name", Value: "system.views"}, {Key: "type", Value: "collection"}, {Key: "options", Value: bson.D{}}},
+	))
+	srv.Handle("aggregate", mongotest.Collections(map[string][]bson.D{
+		"app.orders": {
+			{{Key: "shard", Value: "shard01"}, {Key: "storageStats", Value: bson.D{{Key: "count", Value: int32(10)}, {Key: "size", Value: 2048.0}, {Key: "nindexes", Value: int32(2)}}}},
+			{{Key: "shard", Value: "shard02"}, {Key: "storageStats", Value: bson.D{{Key: "count", Value: int32(5)}, {Key: "size", Value: 1024.0}, {Key: "nindexes", Value: int32(2)}}}},
+		},
+	}))
+
+	d := &DataSourceCollection{Executor: newTestExecutor(srv)}
+
+	regex, statistics := "orders", true
+
+	got, err := d.Read(context.Background(), types.Collections{Database: "app", NameRegex: &regex, Statistics: &statistics})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got.Collections) != 2 || got.Collections[0].Name != "active_orders" || got.Collections[1].Name != "orders" {
+		t.Fatalf("expected active_orders and orders without the system collections, got %+v", got.Collections)
+	}
+
+	view, orders := got.Collections[0], got.Collections[1]
+
+	if view.Type != "view" || view.Options != `{"viewOn":"orders","pipeline":[]}` || view.Validator != nil || view.Stats != nil {
+		t.Fatalf("expected the view options without statistics, got %+v", view)
+	}
+
+	if orders.Validator == nil || *orders.Validator != `{"total":{"$gte":0}}` {
+		t.Fatalf("expected the validator of orders, got %v", orders.Validator)
+	}
+
+	if orders.Options != `{"validationLevel":"strict"}` {
+		t.Fatalf("expected the options of orders without the validator, got %s", orders.Options)
+	}
+
+	if want := (types.CollectionStats{Count: 15, Size: 3072, Indexes: 2}); orders.Stats == nil || *orders.Stats != want {
+		t.Fatalf("expected statistics %+v summed over the shards, got %+v", want, orders.Stats)
+	}
+
+	if v := lookup(t, srv.Commands("listCollections")[0], "filter", "name", "$regex").StringValue(); v != regex {
+		t.Fatalf("expected regex %s, got %s", regex, v)
+	}
+
+	if calls := srv.Calls("aggregate"); calls != 1 {
+		t.Fatalf("expected $collStats of orders only, got %d aggregate calls", calls)
+	}
+}
+
+func TestDataSourceCollectionReadEmpty(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	d := &DataSourceCollection{Executor: newTestExecutor(srv)}
+
+	got, err := d.Read(context.Background(), types.Collections{Database: "app"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Collections == nil || len(got.Collections) != 0 {
+		t.Fatalf("expected an empty list, got %+v", got.Collections)
+	}
+}
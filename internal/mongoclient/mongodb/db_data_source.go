@@ -4,79 +4,62 @@ import (
 	"context"
 	"fmt"
 
+	"terraform-provider-mongodb/internal/mongoclient/executor"
 	"terraform-provider-mongodb/internal/mongoclient/types"
 
-	"github.com/avast/retry-go/v4"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
-func (d *DataSourceDatabase) Read(ctx context.Context) (types.Databases, error) {
-	ds := types.Databases{}
-
-	err := retry.Do(
-		func() error {
-			c, err := d.connect(ctx)
-			if err != nil {
-				return fmt.Errorf("connection to MongoDB failed with error: %s", err)
-			}
+// Read lists the databases without the default databases, filtered by the name regex on the server.
+// With statistics, the size on disk reported by listDatabases is completed with dbStats of every database.
+func (d *DataSourceDatabase) Read(ctx context.Context, config types.DataSourceDatabases) (types.DataSourceDatabases, error) {
+	name := bson.D{{Key: "$nin", Value: types.DefaultDatabases}}
+	if config.NameRegex != nil {
+		name = append(name, bson.E{Key: "$regex", Value: *config.NameRegex})
+	}
 
-			defer func() {
-				disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-				_ = c.Disconnect(disconnectCtx)
-				cancel()
-			}()
+	op := executor.Operation{Name: "read databases"}
 
-			list, err := c.ListDatabaseNames(
-				ctx,
-				bson.M{
-					"name": bson.M{
-						"$nin": types.DefaultDatabases,
-					},
-				},
-			)
-			if err != nil {
-				return fmt.Errorf("list databases failed with error: %s", err)
-			}
+	err := d.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		config.Databases = nil
 
-			for _, i := range list {
-				ds.Databases = append(ds.Databases, types.Database{
-					Name: i,
-				})
-			}
+		list, err := c.ListDatabases(
+			ctx,
+			bson.D{{Key: "name", Value: name}},
+			options.ListDatabases().SetNameOnly(!config.WithStatistics()),
+		)
+		if err != nil {
+			return fmt.Errorf("list databases failed with error: %w", err)
+		}
 
-			if len(list) == 0 {
-				return retry.Unrecoverable(fmt.Errorf("databases not found. You can create a database using resource mongodb_database"))
-			}
+		for _, spec := range list.Databases {
+			database := types.DataSourceDatabase{Name: spec.Name}
 
-			return nil
-		},
-		retry.Attempts(d.RetryAttempts),
-		retry.DelayType(retry.BackOffDelay),
-		retry.Delay(d.RetryDelay),
-		retry.Context(ctx),
-	)
+			if config.WithStatistics() {
+				stats, err := databaseStats(ctx, c, spec.Name)
+				if err != nil {
+					return err
+				}
 
-	return ds, err
-}
+				stats.SizeOnDisk, stats.Empty = spec.SizeOnDisk, spec.Empty
+				database.Stats = &stats
+			}
 
-func (d *DataSourceDatabase) connect(ctx context.Context) (*mongo.Client, error) {
-	opts := options.Client().ApplyURI(d.Uri)
+			config.Databases = append(config.Databases, database)
+		}
 
-	client, err := mongo.Connect(opts)
-	if err != nil {
-		return nil, err
-	}
+		if len(config.Databases) == 0 {
+			if config.NameRegex != nil {
+				return executor.Unrecoverable(fmt.Errorf("databases matching %q not found", *config.NameRegex))
+			}
 
-	err = client.Ping(ctx, nil)
-	if err != nil {
-		disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-		_ = client.Disconnect(disconnectCtx)
-		cancel()
+			return executor.Unrecoverable(fmt.Errorf("databases not found. You can create a database using resource mongodb_database"))
+		}
 
-		return nil, fmt.Errorf("failed to ping MongoDB: %s", err)
-	}
+		return nil
+	})
 
-	return client, nil
+	return config, err
 }
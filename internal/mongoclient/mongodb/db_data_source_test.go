@@ -0,0 +1,97 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestDataSourceDatabaseRead(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("listDatabases", listDatabases("app", "reporting"))
+
+	d := &DataSourceDatabase{Executor: newTestExecutor(srv)}
+
+	got, err := d.Read(context.Background(), types.DataSourceDatabases{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got.Databases) != 2 || got.Databases[0].Name != "app" || got.Databases[0].Stats != nil {
+		t.Fatalf("expected databases app and reporting without statistics, got %+v", got.Databases)
+	}
+
+	cmd := srv.Commands("listDatabases")[0]
+
+	if !lookup(t, cmd, "nameOnly").Boolean() {
+		t.Fatal("expected names only without statistics")
+	}
+
+	if _, err := cmd.LookupErr("filter", "name", "$regex"); err == nil {
+		t.Fatal("expected no regex without name_regex")
+	}
+
+	if srv.Calls("dbStats") != 0 {
+		t.Fatal("expected no dbStats without statistics")
+	}
+}
+
+func TestDataSourceDatabaseReadStatistics(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("listDatabases", mongotest.Reply(bson.E{Key: "databases", Value: bson.A{
+		bson.D{{Key: "name", Value: "app_eu"}, {Key: "sizeOnDisk", Value: int64(8192)}, {Key: "empty", Value: false}},
+	}}))
+	srv.Handle("dbStats", mongotest.Reply(
+		bson.E{Key: "collections", Value: int32(2)},
+		bson.E{Key: "objects", Value: int64(10)},
+		bson.E{Key: "dataSize", Value: 2048.0},
+		bson.E{Key: "storageSize", Value: 4096.0},
+		bson.E{Key: "indexSize", Value: 1024.0},
+	))
+
+	d := &DataSourceDatabase{Executor: newTestExecutor(srv)}
+
+	regex, statistics := "^app_", true
+
+	got, err := d.Read(context.Background(), types.DataSourceDatabases{NameRegex: &regex, Statistics: &statistics})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := types.DatabaseStats{SizeOnDisk: 8192, DataSize: 2048, StorageSize: 4096, IndexSize: 1024, Collections: 2, Objects: 10}
+	if len(got.Databases) != 1 || got.Databases[0].Stats == nil || *got.Databases[0].Stats != want {
+		t.Fatalf("expected app_eu with statistics %+v, got %+v", want, got.Databases)
+	}
+
+	cmd := srv.Commands("listDatabases")[0]
+
+	if v := lookup(t, cmd, "filter", "name", "$regex").StringValue(); v != regex {
+		t.Fatalf("expected regex %s, got %s", regex, v)
+	}
+
+	if v := lookup(t, srv.Commands("dbStats")[0], "$db").StringValue(); v != "app_eu" {
+		t.Fatalf("expected dbStats on app_eu, got %s", v)
+	}
+}
+
+func TestDataSourceDatabaseReadNotFound(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	d := &DataSourceDatabase{Executor: newTestExecutor(srv)}
+
+	regex := "^app_"
+
+	_, err := d.Read(context.Background(), types.DataSourceDatabases{NameRegex: &regex})
+	if err == nil || !strings.Contains(err.Error(), `databases matching "^app_" not found`) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	if calls := srv.Calls("listDatabases"); calls != 1 {
+		t.Fatalf("expected no retries, got %d listDatabases calls", calls)
+	}
+}
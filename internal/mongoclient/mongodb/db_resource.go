@@ -4,11 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"terraform-provider-mongodb/internal/mongoclient/executor"
 	"terraform-provider-mongodb/internal/mongoclient/types"
 
-	"github.com/avast/retry-go/v4"
 	"go.mongodb.org/mongo-driver/v2/mongo"
-	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 func (r *ResourceDatabase) Create(ctx context.Context, plan types.Database) error {
@@ -16,156 +15,120 @@ func (r *ResourceDatabase) Create(ctx context.Context, plan types.Database) erro
 		return fmt.Errorf("database %s is a default database and cannot be created", plan.Name)
 	}
 
-	err := retry.Do(
-		func() error {
-			c, err := r.connect(ctx)
-			if err != nil {
-				return fmt.Errorf("connection to MongoDB failed with error: %s", err)
-			}
-
-			defer func() {
-				disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-				_ = c.Disconnect(disconnectCtx)
-				cancel()
-			}()
+	if plan.Mode() == types.DatabaseMarkerModeNone && len(plan.Collections) == 0 {
+		return fmt.Errorf("database %s needs collections without a marker collection, MongoDB does not create empty databases", plan.Name)
+	}
 
-			exist, err := databaseExists(ctx, c, plan.Name)
-			if err != nil {
-				return fmt.Errorf("failed to check if database exists: %s", err)
-			}
+	op := executor.Operation{Name: "create database"}
 
-			if exist {
-				return retry.Unrecoverable(fmt.Errorf("database %s already exists", plan.Name))
-			}
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		exist, err := databaseExists(ctx, c, plan.Name)
+		if err != nil {
+			return fmt.Errorf("failed to check if database exists: %w", err)
+		}
 
-			return createDatabase(ctx, c, plan.Name)
-		},
-		retry.Attempts(r.RetryAttempts),
-		retry.DelayType(retry.BackOffDelay),
-		retry.Delay(r.RetryDelay),
-		retry.Context(ctx),
-	)
+		if exist {
+			return executor.Unrecoverable(fmt.Errorf("database %s already exists", plan.Name))
+		}
 
-	return err
+		return createDatabase(ctx, c, plan)
+	})
 }
 
-func (r *ResourceDatabase) Exists(ctx context.Context, state types.Database) (bool, error) {
-	var exist bool
+// Update creates the declared collections which do not exist yet and, in the remove marker mode, drops
+// the marker collection. Collections removed from the plan are kept.
+func (r *ResourceDatabase) Update(ctx context.Context, plan types.Database) error {
+	op := executor.Operation{Name: "update database"}
 
-	err := retry.Do(
-		func() error {
-			c, err := r.connect(ctx)
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		for _, name := range plan.Collections {
+			err := createCollection(ctx, c.Database(plan.Name), name)
 			if err != nil {
-				return fmt.Errorf("connection to MongoDB failed with error: %s", err)
+				return err
 			}
+		}
 
-			defer func() {
-				disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-				_ = c.Disconnect(disconnectCtx)
-				cancel()
-			}()
+		if plan.Mode() != types.DatabaseMarkerModeRemove {
+			return nil
+		}
 
-			exist, err = databaseExists(ctx, c, state.Name)
+		return removeMarker(ctx, c, plan.Name, plan.Marker())
+	})
+}
 
-			return err
-		},
-		retry.Attempts(r.RetryAttempts),
-		retry.DelayType(retry.BackOffDelay),
-		retry.Delay(r.RetryDelay),
-		retry.Context(ctx),
-	)
+// Read returns the state with marker_present set if the remove mode left the marker collection next to
+// another collection, which the next update drops, and whether the database exists.
+func (r *ResourceDatabase) Read(ctx context.Context, state types.Database) (types.Database, bool, error) {
+	var exist, present bool
 
-	return exist, err
-}
+	op := executor.Operation{Name: "read database"}
 
-func (r *ResourceDatabase) Delete(ctx context.Context, state types.Database) error {
-	err := retry.Do(
-		func() error {
-			c, err := r.connect(ctx)
-			if err != nil {
-				return fmt.Errorf("connection to MongoDB failed with error: %s", err)
-			}
+	err := r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		var err error
 
-			defer func() {
-				disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-				_ = c.Disconnect(disconnectCtx)
-				cancel()
-			}()
+		exist, err = databaseExists(ctx, c, state.Name)
+		if err != nil {
+			return fmt.Errorf("failed to check if database exists: %w", err)
+		}
 
-			exist, err := databaseExists(ctx, c, state.Name)
-			if err != nil {
-				return fmt.Errorf("database exist check failed with error: %s", err)
-			}
+		if !exist || state.Mode() != types.DatabaseMarkerModeRemove {
+			return nil
+		}
 
-			if !exist {
-				return retry.Unrecoverable(fmt.Errorf("database %s does not exist", state.Name))
-			}
+		present, err = markerRemovable(ctx, c, state.Name, state.Marker())
 
-			return deleteDatabase(ctx, c, state.Name)
-		},
-		retry.Attempts(r.RetryAttempts),
-		retry.DelayType(retry.BackOffDelay),
-		retry.Delay(r.RetryDelay),
-		retry.Context(ctx),
-	)
+		return err
+	})
 
-	return err
+	state.MarkerPresent = &present
+
+	return state, exist, err
 }
 
-func (r *ResourceDatabase) ImportState(ctx context.Context, name string) (types.Database, error) {
-	if isDefaultDatabase(name) {
-		return types.Database{}, fmt.Errorf("database %s is a default database and cannot be imported", name)
-	}
+// Delete drops the database. With drop_only_if_empty, a database holding documents besides the marker is kept.
+func (r *ResourceDatabase) Delete(ctx context.Context, state types.Database) error {
+	op := executor.Operation{Name: "delete database"}
 
-	err := retry.Do(
-		func() error {
-			c, err := r.connect(ctx)
-			if err != nil {
-				return fmt.Errorf("connection to MongoDB failed with error: %s", err)
-			}
+	return r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		exist, err := databaseExists(ctx, c, state.Name)
+		if err != nil {
+			return fmt.Errorf("failed to check if database exists: %w", err)
+		}
 
-			defer func() {
-				disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-				_ = c.Disconnect(disconnectCtx)
-				cancel()
-			}()
+		if !exist {
+			return executor.Unrecoverable(fmt.Errorf("database %s does not exist", state.Name))
+		}
 
-			exist, err := databaseExists(ctx, c, name)
+		if state.IsDropOnlyIfEmpty() {
+			err = requireEmptyDatabase(ctx, c, state.Name, state.Marker())
 			if err != nil {
-				return fmt.Errorf("database exist check failed with error: %s", err)
-			}
-
-			if !exist {
-				return retry.Unrecoverable(fmt.Errorf("database %s does not exist", name))
+				return err
 			}
+		}
 
-			return nil
-		},
-		retry.Attempts(r.RetryAttempts),
-		retry.DelayType(retry.BackOffDelay),
-		retry.Delay(r.RetryDelay),
-		retry.Context(ctx),
-	)
-
-	return types.Database{Name: name}, err
+		return deleteDatabase(ctx, c, state.Name)
+	})
 }
 
-func (r *ResourceDatabase) connect(ctx context.Context) (*mongo.Client, error) {
-	opts := options.Client().ApplyURI(r.Uri)
-
-	client, err := mongo.Connect(opts)
-	if err != nil {
-		return nil, err
+func (r *ResourceDatabase) ImportState(ctx context.Context, name string) (types.Database, error) {
+	if isDefaultDatabase(name) {
+		return types.Database{}, fmt.Errorf("database %s is a default database and cannot be imported", name)
 	}
 
-	err = client.Ping(ctx, nil)
-	if err != nil {
-		disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-		_ = client.Disconnect(disconnectCtx)
-		cancel()
+	op := executor.Operation{Name: "import database"}
 
-		return nil, fmt.Errorf("failed to ping MongoDB: %s", err)
-	}
+	err := r.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		exist, err := databaseExists(ctx, c, name)
+		if err != nil {
+			return fmt.Errorf("failed to check if database exists: %w", err)
+		}
+
+		if !exist {
+			return executor.Unrecoverable(fmt.Errorf("database %s does not exist", name))
+		}
 
-	return client, nil
+		return nil
+	})
+
+	return types.Database{Name: name}, err
 }
@@ -0,0 +1,376 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// listDatabases returns a listDatabases reply with the databases.
+func listDatabases(names ...string) mongotest.Handler {
+	databases := bson.A{}
+	for _, name := range names {
+		databases = append(databases, bson.D{{Key: "name", Value: name}})
+	}
+
+	return mongotest.Reply(bson.E{Key: "databases", Value: databases})
+}
+
+func TestResourceDatabaseCreate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	r := &ResourceDatabase{Executor: newTestExecutor(srv)}
+
+	if err := r.Create(context.Background(), types.Database{Name: "app"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmds := srv.Commands("insert")
+	if len(cmds) != 1 {
+		t.Fatalf("expected 1 insert, got %d", len(cmds))
+	}
+
+	if db := lookup(t, cmds[0], "$db").StringValue(); db != "app" {
+		t.Fatalf("expected insert into database app, got %s", db)
+	}
+}
+
+func TestResourceDatabaseCreateCollections(t *testing.T) {
+	tests := []struct {
+		name        string
+		mode        string
+		marker      *string
+		collections []string
+		wantCreates []string
+		wantInsert  string
+		wantErr     string
+	}{
+		{
+			name:        "keep marker",
+			mode:        types.DatabaseMarkerModeKeep,
+			collections: []string{"orders"},
+			wantCreates: []string{"orders"},
+			wantInsert:  "created_by_terraform",
+		},
+		{
+			name:        "custom marker",
+			mode:        types.DatabaseMarkerModeRemove,
+			marker:      func() *string { m := "_marker"; return &m }(),
+			wantInsert:  "_marker",
+			wantCreates: []string{},
+		},
+		{
+			name:        "remove marker with collections",
+			mode:        types.DatabaseMarkerModeRemove,
+			collections: []string{"orders", "customers"},
+			wantCreates: []string{"orders", "customers"},
+		},
+		{
+			name:        "no marker",
+			mode:        types.DatabaseMarkerModeNone,
+			collections: []string{"orders"},
+			wantCreates: []string{"orders"},
+		},
+		{
+			name:    "no marker without collections",
+			mode:    types.DatabaseMarkerModeNone,
+			wantErr: "needs collections",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			r := &ResourceDatabase{Executor: newTestExecutor(srv)}
+
+			err := r.Create(context.Background(), types.Database{
+				Name:             "app",
+				Collections:      tt.collections,
+				MarkerCollection: tt.marker,
+				MarkerMode:       &tt.mode,
+			})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			creates := []string{}
+			for _, cmd := range srv.Commands("create") {
+				creates = append(creates, lookup(t, cmd, "create").StringValue())
+			}
+
+			if strings.Join(creates, ",") != strings.Join(tt.wantCreates, ",") {
+				t.Fatalf("expected collections %v to be created, got %v", tt.wantCreates, creates)
+			}
+
+			inserts := srv.Commands("insert")
+			if tt.wantInsert == "" {
+				if len(inserts) != 0 {
+					t.Fatalf("expected no marker, got %d inserts", len(inserts))
+				}
+
+				return
+			}
+
+			if len(inserts) != 1 || lookup(t, inserts[0], "insert").StringValue() != tt.wantInsert {
+				t.Fatalf("expected a marker in %s, got %v", tt.wantInsert, inserts)
+			}
+		})
+	}
+}
+
+func TestResourceDatabaseCreateExistingCollection(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("create", mongotest.Fail(48))
+
+	r := &ResourceDatabase{Executor: newTestExecutor(srv)}
+
+	mode := types.DatabaseMarkerModeNone
+	if err := r.Create(context.Background(), types.Database{Name: "app", Collections: []string{"orders"}, MarkerMode: &mode}); err != nil {
+		t.Fatalf("expected an existing collection to be kept, got %s", err)
+	}
+}
+
+func TestResourceDatabaseRead(t *testing.T) {
+	collection := func(name string) bson.D {
+		return bson.D{{Key: "name", Value: name}, {Key: "type", Value: "collection"}}
+	}
+
+	tests := []struct {
+		name        string
+		mode        string
+		databases   []string
+		collections []bson.D
+		wantExist   bool
+		wantPresent bool
+	}{
+		{
+			name:        "other collection",
+			mode:        types.DatabaseMarkerModeRemove,
+			databases:   []string{"app"},
+			collections: []bson.D{collection("created_by_terraform"), collection("orders")},
+			wantExist:   true,
+			wantPresent: true,
+		},
+		{
+			name:        "only marker",
+			mode:        types.DatabaseMarkerModeRemove,
+			databases:   []string{"app"},
+			collections: []bson.D{collection("created_by_terraform"), collection("system.views")},
+			wantExist:   true,
+		},
+		{
+			name:        "marker already removed",
+			mode:        types.DatabaseMarkerModeRemove,
+			databases:   []string{"app"},
+			collections: []bson.D{collection("orders")},
+			wantExist:   true,
+		},
+		{
+			name:        "keep mode",
+			mode:        types.DatabaseMarkerModeKeep,
+			databases:   []string{"app"},
+			collections: []bson.D{collection("created_by_terraform"), collection("orders")},
+			wantExist:   true,
+		},
+		{
+			name: "missing database",
+			mode: types.DatabaseMarkerModeRemove,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.Handle("listDatabases", listDatabases(tt.databases...))
+			srv.Handle("listCollections", mongotest.Cursor(tt.collections...))
+
+			r := &ResourceDatabase{Executor: newTestExecutor(srv)}
+
+			got, exist, err := r.Read(context.Background(), types.Database{Name: "app", MarkerMode: &tt.mode})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if exist != tt.wantExist {
+				t.Fatalf("expected database exists: %t, got %t", tt.wantExist, exist)
+			}
+
+			if got.IsMarkerPresent() != tt.wantPresent {
+				t.Fatalf("expected marker present: %t, got %t", tt.wantPresent, got.IsMarkerPresent())
+			}
+
+			if got.Mode() != tt.mode {
+				t.Fatalf("expected marker mode %s to be kept, got %s", tt.mode, got.Mode())
+			}
+
+			if drops := srv.Commands("drop"); len(drops) != 0 {
+				t.Fatalf("expected no drop, got %v", drops)
+			}
+		})
+	}
+}
+
+func TestResourceDatabaseUpdate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("listCollections", mongotest.Cursor(
+		bson.D{{Key: "name", Value: "created_by_terraform"}, {Key: "type", Value: "collection"}},
+		bson.D{{Key: "name", Value: "orders"}, {Key: "type", Value: "collection"}},
+	))
+
+	r := &ResourceDatabase{Executor: newTestExecutor(srv)}
+
+	mode := types.DatabaseMarkerModeRemove
+	if err := r.Update(context.Background(), types.Database{Name: "app", Collections: []string{"orders"}, MarkerMode: &mode}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if v := lookup(t, srv.Commands("create")[0], "create").StringValue(); v != "orders" {
+		t.Fatalf("expected create of orders, got %s", v)
+	}
+
+	if srv.Calls("drop") != 1 {
+		t.Fatal("expected the marker to be dropped")
+	}
+}
+
+func TestResourceDatabaseDelete(t *testing.T) {
+	tests := []struct {
+		name      string
+		databases []string
+		wantErr   string
+		wantDrops int
+	}{
+		{name: "drops database", databases: []string{"app"}, wantDrops: 1},
+		{name: "database does not exist", wantErr: "database app does not exist"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.Handle("listDatabases", listDatabases(tt.databases...))
+
+			r := &ResourceDatabase{Executor: newTestExecutor(srv)}
+
+			err := r.Delete(context.Background(), types.Database{Name: "app"})
+
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+
+			if calls := srv.Calls("listDatabases"); calls != 1 {
+				t.Fatalf("expected no retries, got %d listDatabases calls", calls)
+			}
+
+			if drops := srv.Calls("dropDatabase"); drops != tt.wantDrops {
+				t.Fatalf("expected %d dropDatabase calls, got %d", tt.wantDrops, drops)
+			}
+		})
+	}
+}
+
+func TestResourceDatabaseDeleteOnlyIfEmpty(t *testing.T) {
+	collection := func(name string) bson.D {
+		return bson.D{{Key: "name", Value: name}, {Key: "type", Value: "collection"}}
+	}
+	view := bson.D{{Key: "name", Value: "active_orders"}, {Key: "type", Value: "view"}}
+
+	tests := []struct {
+		name        string
+		onlyIfEmpty bool
+		collections []bson.D
+		documents   map[string]int64
+		wantErr     string
+	}{
+		{
+			name:        "only marker",
+			onlyIfEmpty: true,
+			collections: []bson.D{collection("created_by_terraform"), collection("system.views"), collection("system.js"), view},
+			documents:   map[string]int64{"created_by_terraform": 1, "system.views": 1, "system.js": 2, "active_orders": 5},
+		},
+		{
+			name:        "empty collections",
+			onlyIfEmpty: true,
+			collections: []bson.D{collection("created_by_terraform"), collection("orders")},
+			documents:   map[string]int64{"created_by_terraform": 1},
+		},
+		{
+			name:        "documents",
+			onlyIfEmpty: true,
+			collections: []bson.D{collection("created_by_terraform"), collection("orders"), collection("customers"), collection("archive")},
+			documents:   map[string]int64{"created_by_terraform": 1, "orders": 40, "customers": 2},
+			wantErr:     "holds 42 documents in the collections customers, orders",
+		},
+		{
+			name:        "documents without marker",
+			onlyIfEmpty: true,
+			collections: []bson.D{collection("orders"), collection("system.views")},
+			documents:   map[string]int64{"orders": 1, "system.views": 1},
+			wantErr:     "holds 1 documents in the collections orders",
+		},
+		{
+			name:        "not only if empty",
+			collections: []bson.D{collection("orders")},
+			documents:   map[string]int64{"orders": 42},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.Handle("listDatabases", listDatabases("app"))
+			srv.Handle("listCollections", mongotest.Cursor(tt.collections...))
+			srv.Handle("count", func(cmd bson.Raw) bson.D {
+				return bson.D{{Key: "n", Value: tt.documents[cmd.Index(0).Value().StringValue()]}, {Key: "ok", Value: 1}}
+			})
+
+			r := &ResourceDatabase{Executor: newTestExecutor(srv)}
+
+			err := r.Delete(context.Background(), types.Database{Name: "app", DropOnlyIfEmpty: &tt.onlyIfEmpty})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+
+				if srv.Calls("dropDatabase") != 0 || srv.Calls("listDatabases") != 1 {
+					t.Fatal("expected the database to be kept without retries")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if srv.Calls("dropDatabase") != 1 {
+				t.Fatal("expected the database to be dropped")
+			}
+
+			if !tt.onlyIfEmpty && srv.Calls("listCollections") != 0 {
+				t.Fatal("expected no collections check")
+			}
+
+			if tt.onlyIfEmpty {
+				if v := lookup(t, srv.Commands("listCollections")[0], "filter", "type").StringValue(); v != "collection" {
+					t.Fatalf("expected only collections listed, got type %s", v)
+				}
+			}
+		})
+	}
+}
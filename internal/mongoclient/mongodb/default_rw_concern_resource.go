@@ -0,0 +1,212 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// rwConcernDefaults is the reply of getDefaultRWConcern. The sources tell a default set with setDefaultRWConcern
+// from the implicit default of the server.
+type rwConcernDefaults struct {
+	DefaultWriteConcern struct {
+		W        bson.RawValue `bson:"w"`
+		J        *bool         `bson:"j"`
+		WTimeout *int64        `bson:"wtimeout"`
+	} `bson:"defaultWriteConcern"`
+	DefaultWriteConcernSource string `bson:"defaultWriteConcernSource"`
+	DefaultReadConcern        struct {
+		Level string `bson:"level"`
+	} `bson:"defaultReadConcern"`
+	DefaultReadConcernSource string `bson:"defaultReadConcernSource"`
+}
+
+func (r *ResourceDefaultRWConcern) Create(ctx context.Context, plan types.DefaultRWConcern) error {
+	op := executor.Operation{Name: "create default read/write concern"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		return setDefaultRWConcern(ctx, c, plan.WriteConcern, plan.ReadConcern)
+	})
+}
+
+func (r *ResourceDefaultRWConcern) Update(ctx context.Context, plan types.DefaultRWConcern) error {
+	op := executor.Operation{Name: "update default read/write concern"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		return setDefaultRWConcern(ctx, c, plan.WriteConcern, plan.ReadConcern)
+	})
+}
+
+// Delete unsets the default read concern. MongoDB refuses to unset the default write concern once it is set,
+// so it is reset to majority, the implicit default of replica sets without arbiters.
+func (r *ResourceDefaultRWConcern) Delete(ctx context.Context, state types.DefaultRWConcern) error {
+	op := executor.Operation{Name: "delete default read/write concern"}
+
+	return r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		return setDefaultRWConcern(ctx, c, types.MajorityWriteConcern(), nil)
+	})
+}
+
+// Read returns the current defaults. Write concern options the server reports with their default values
+// are kept unset when they are unset in the state.
+func (r *ResourceDefaultRWConcern) Read(ctx context.Context, state types.DefaultRWConcern) (types.DefaultRWConcern, error) {
+	var d rwConcernDefaults
+
+	op := executor.Operation{Name: "read default read/write concern"}
+
+	err := r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		var err error
+
+		d, err = getDefaultRWConcern(ctx, c)
+
+		return err
+	})
+
+	if err != nil {
+		return state, err
+	}
+
+	current, err := defaultRWConcernFromReply(d)
+	if err != nil {
+		return state, err
+	}
+
+	wtimeout := d.DefaultWriteConcern.WTimeout
+	if wtimeout != nil && *wtimeout == 0 && state.WriteConcern.WTimeout == nil {
+		current.WriteConcern.WTimeout = nil
+	}
+
+	current.Retry = state.Retry
+	current.Timeouts = state.Timeouts
+
+	return current, nil
+}
+
+func (r *ResourceDefaultRWConcern) ImportState(ctx context.Context) (types.DefaultRWConcern, error) {
+	var d rwConcernDefaults
+
+	op := executor.Operation{Name: "import default read/write concern"}
+
+	err := r.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		var err error
+
+		d, err = getDefaultRWConcern(ctx, c)
+
+		return err
+	})
+
+	if err != nil {
+		return types.DefaultRWConcern{}, err
+	}
+
+	current, err := defaultRWConcernFromReply(d)
+	if err != nil {
+		return types.DefaultRWConcern{}, err
+	}
+
+	if current.WriteConcern.WTimeout != nil && *current.WriteConcern.WTimeout == 0 {
+		current.WriteConcern.WTimeout = nil
+	}
+
+	return current, nil
+}
+
+// setDefaultRWConcern sets the defaults. A nil read concern unsets the default read concern.
+func setDefaultRWConcern(ctx context.Context, client *mongo.Client, wc types.WriteConcern, rc *types.ReadConcern) error {
+	readConcern := bson.D{}
+	if rc != nil {
+		readConcern = bson.D{{Key: "level", Value: rc.Level}}
+	}
+
+	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+		{Key: "setDefaultRWConcern", Value: 1},
+		{Key: "defaultWriteConcern", Value: writeConcernDocument(wc)},
+		{Key: "defaultReadConcern", Value: readConcern},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("setDefaultRWConcern failed with error: %w", err)
+	}
+
+	return nil
+}
+
+// getDefaultRWConcern returns the defaults of the cluster.
+func getDefaultRWConcern(ctx context.Context, client *mongo.Client) (rwConcernDefaults, error) {
+	d := rwConcernDefaults{}
+
+	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+		{Key: "getDefaultRWConcern", Value: 1},
+	}).Decode(&d)
+	if err != nil {
+		return d, fmt.Errorf("getDefaultRWConcern failed with error: %w", err)
+	}
+
+	return d, nil
+}
+
+// writeConcernDocument returns the write concern document. A numeric w is sent as a number of members,
+// anything else as majority or a custom write concern name.
+func writeConcernDocument(wc types.WriteConcern) bson.D {
+	d := bson.D{}
+
+	if wc.W != nil {
+		d = bson.D{{Key: "w", Value: *wc.W}}
+
+		if n, err := strconv.Atoi(*wc.W); err == nil {
+			d = bson.D{{Key: "w", Value: n}}
+		}
+	}
+
+	if wc.J != nil {
+		d = append(d, bson.E{Key: "j", Value: *wc.J})
+	}
+
+	if wc.WTimeout != nil {
+		d = append(d, bson.E{Key: "wtimeout", Value: *wc.WTimeout})
+	}
+
+	return d
+}
+
+// defaultRWConcernFromReply converts the reply of getDefaultRWConcern. The implicit default read concern
+// of the server is unset, as is w when the server reports no default write concern, e.g. on a new cluster.
+func defaultRWConcernFromReply(d rwConcernDefaults) (types.DefaultRWConcern, error) {
+	w := d.DefaultWriteConcern.W
+
+	c := types.DefaultRWConcern{
+		WriteConcern: types.WriteConcern{
+			J:        d.DefaultWriteConcern.J,
+			WTimeout: d.DefaultWriteConcern.WTimeout,
+		},
+	}
+
+	switch w.Type {
+	case 0, bson.TypeNull:
+		// defaultWriteConcern or its w is missing
+	case bson.TypeString:
+		s := w.StringValue()
+		c.WriteConcern.W = &s
+	case bson.TypeInt32, bson.TypeInt64, bson.TypeDouble:
+		n, ok := w.AsInt64OK()
+		if !ok {
+			return c, fmt.Errorf("unexpected w of the default write concern: %s", w)
+		}
+
+		s := strconv.FormatInt(n, 10)
+		c.WriteConcern.W = &s
+	default:
+		return c, fmt.Errorf("unexpected w of the default write concern: %s", w)
+	}
+
+	if d.DefaultReadConcernSource == types.DefaultRWConcernSourceGlobal && d.DefaultReadConcern.Level != "" {
+		c.ReadConcern = &types.ReadConcern{Level: d.DefaultReadConcern.Level}
+	}
+
+	return c, nil
+}
@@ -0,0 +1,157 @@
+package mongodb
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestResourceDefaultRWConcernCreate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceDefaultRWConcern{Executor: newTestExecutor(srv)}
+
+	w, wtimeout := "2", int64(5000)
+	plan := types.DefaultRWConcern{
+		WriteConcern: types.WriteConcern{W: &w, WTimeout: &wtimeout},
+		ReadConcern:  &types.ReadConcern{Level: "majority"},
+	}
+
+	if err := r.Create(context.Background(), plan); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmd := srv.Commands("setDefaultRWConcern")[0]
+
+	if w := lookup(t, cmd, "defaultWriteConcern", "w"); w.Type != bson.TypeInt32 || w.Int32() != 2 {
+		t.Fatalf("expected numeric w 2, got %s", w)
+	}
+
+	if _, err := cmd.LookupErr("defaultWriteConcern", "j"); err == nil {
+		t.Fatal("expected unset j")
+	}
+
+	if level := lookup(t, cmd, "defaultReadConcern", "level").StringValue(); level != "majority" {
+		t.Fatalf("expected read concern majority, got %s", level)
+	}
+}
+
+func TestResourceDefaultRWConcernDelete(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceDefaultRWConcern{Executor: newTestExecutor(srv)}
+
+	if err := r.Delete(context.Background(), types.DefaultRWConcern{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmd := srv.Commands("setDefaultRWConcern")[0]
+
+	if w := lookup(t, cmd, "defaultWriteConcern", "w").StringValue(); w != types.WriteConcernMajority {
+		t.Fatalf("expected write concern reset to majority, got %s", w)
+	}
+
+	rc := lookup(t, cmd, "defaultReadConcern").Document()
+	if elems, _ := rc.Elements(); len(elems) != 0 {
+		t.Fatalf("expected empty read concern to unset it, got %s", rc)
+	}
+}
+
+func TestResourceDefaultRWConcernRead(t *testing.T) {
+	tests := []struct {
+		name      string
+		reply     []bson.E
+		state     types.DefaultRWConcern
+		wantW     string
+		wantLevel string
+		wantWTime bool
+	}{
+		{
+			name:  "implicit defaults",
+			state: types.DefaultRWConcern{WriteConcern: types.MajorityWriteConcern()},
+			wantW: types.WriteConcernMajority,
+		},
+		{
+			name: "global defaults",
+			reply: []bson.E{
+				{Key: "defaultWriteConcern", Value: bson.D{{Key: "w", Value: int32(1)}, {Key: "wtimeout", Value: int32(0)}}},
+				{Key: "defaultWriteConcernSource", Value: "global"},
+				{Key: "defaultReadConcern", Value: bson.D{{Key: "level", Value: "available"}}},
+				{Key: "defaultReadConcernSource", Value: "global"},
+			},
+			state:     types.DefaultRWConcern{WriteConcern: types.MajorityWriteConcern()},
+			wantW:     "1",
+			wantLevel: "available",
+		},
+		{
+			name: "no default write concern",
+			reply: []bson.E{
+				{Key: "defaultReadConcern", Value: bson.D{{Key: "level", Value: "local"}}},
+				{Key: "defaultReadConcernSource", Value: "implicit"},
+			},
+			state: types.DefaultRWConcern{WriteConcern: types.MajorityWriteConcern()},
+			wantW: "<null>",
+		},
+		{
+			name: "default write concern without w",
+			reply: []bson.E{
+				{Key: "defaultWriteConcern", Value: bson.D{{Key: "wtimeout", Value: int32(0)}}},
+				{Key: "defaultWriteConcernSource", Value: "implicit"},
+			},
+			state: types.DefaultRWConcern{WriteConcern: types.MajorityWriteConcern()},
+			wantW: "<null>",
+		},
+		{
+			name: "zero wtimeout in state",
+			reply: []bson.E{
+				{Key: "defaultWriteConcern", Value: bson.D{{Key: "w", Value: "majority"}, {Key: "wtimeout", Value: int32(0)}}},
+				{Key: "defaultWriteConcernSource", Value: "global"},
+			},
+			state:     types.DefaultRWConcern{WriteConcern: types.WriteConcern{W: types.MajorityWriteConcern().W, WTimeout: new(int64)}},
+			wantW:     types.WriteConcernMajority,
+			wantWTime: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			if tt.reply != nil {
+				srv.Handle("getDefaultRWConcern", mongotest.Reply(tt.reply...))
+			}
+
+			r := &ResourceDefaultRWConcern{Executor: newTestExecutor(srv)}
+
+			got, err := r.Read(context.Background(), tt.state)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			w := "<null>"
+			if got.WriteConcern.W != nil {
+				w = *got.WriteConcern.W
+			}
+
+			if w != tt.wantW {
+				t.Fatalf("expected w %s, got %s", tt.wantW, w)
+			}
+
+			if (got.WriteConcern.WTimeout != nil) != tt.wantWTime {
+				t.Fatalf("expected wtimeout set: %t, got %v", tt.wantWTime, got.WriteConcern.WTimeout)
+			}
+
+			level := ""
+			if got.ReadConcern != nil {
+				level = got.ReadConcern.Level
+			}
+
+			if level != tt.wantLevel {
+				t.Fatalf("expected read concern %q, got %q", tt.wantLevel, level)
+			}
+		})
+	}
+}
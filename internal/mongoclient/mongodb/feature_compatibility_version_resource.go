@@ -0,0 +1,250 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// featureCompatibilityVersion is the featureCompatibilityVersion parameter. TargetVersion is set
+// while an upgrade or a downgrade is in progress.
+type featureCompatibilityVersion struct {
+	Version       string `bson:"version"`
+	TargetVersion string `bson:"targetVersion"`
+}
+
+// confirmFeatureCompatibilityVersion is the first major version which requires setFeatureCompatibilityVersion
+// to be confirmed.
+const confirmFeatureCompatibilityVersion = 7
+
+func (r *ResourceFeatureCompatibilityVersion) Create(ctx context.Context, plan types.FeatureCompatibilityVersion) error {
+	return r.set(ctx, plan, "create feature compatibility version")
+}
+
+func (r *ResourceFeatureCompatibilityVersion) Update(ctx context.Context, plan types.FeatureCompatibilityVersion) error {
+	return r.set(ctx, plan, "update feature compatibility version")
+}
+
+// Delete only removes the resource from the state, the feature compatibility version can't be unset.
+func (r *ResourceFeatureCompatibilityVersion) Delete(_ context.Context, _ types.FeatureCompatibilityVersion) error {
+	return nil
+}
+
+// Read returns the current version, which is the lower version while a transition is in progress.
+func (r *ResourceFeatureCompatibilityVersion) Read(ctx context.Context, state types.FeatureCompatibilityVersion) (types.FeatureCompatibilityVersion, error) {
+	var current featureCompatibilityVersion
+
+	op := executor.Operation{Name: "read feature compatibility version"}
+
+	err := r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		var err error
+
+		current, err = getFeatureCompatibilityVersion(ctx, c)
+
+		return err
+	})
+	if err != nil {
+		return state, err
+	}
+
+	state.Version = current.Version
+
+	return state, nil
+}
+
+func (r *ResourceFeatureCompatibilityVersion) ImportState(ctx context.Context) (types.FeatureCompatibilityVersion, error) {
+	var current featureCompatibilityVersion
+
+	op := executor.Operation{Name: "import feature compatibility version"}
+
+	err := r.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		var err error
+
+		current, err = getFeatureCompatibilityVersion(ctx, c)
+
+		return err
+	})
+	if err != nil {
+		return types.FeatureCompatibilityVersion{}, err
+	}
+
+	return types.FeatureCompatibilityVersion{Version: current.Version}, nil
+}
+
+// set sets the version and waits until every data-bearing member of a replica set has completed the transition.
+// setFeatureCompatibilityVersion itself only waits for a majority of the members. Through a mongos, the command
+// waits for a majority of each shard and the shard members are not polled, as they are not reachable with the
+// credentials of the router in general.
+func (r *ResourceFeatureCompatibilityVersion) set(ctx context.Context, plan types.FeatureCompatibilityVersion, name string) error {
+	var members []string
+
+	op := executor.Operation{Name: name}
+
+	err := r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		current, err := getFeatureCompatibilityVersion(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		cmp, err := types.CompareFeatureCompatibilityVersions(plan.Version, current.Version)
+		if err != nil {
+			return executor.Unrecoverable(err)
+		}
+
+		if cmp < 0 && !plan.IsDowngradeAllowed() {
+			return executor.Unrecoverable(fmt.Errorf("downgrading the feature compatibility version from %s to %s requires allow_downgrade", current.Version, plan.Version))
+		}
+
+		major, err := serverMajorVersion(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		err = setFeatureCompatibilityVersion(ctx, c, plan.Version, major >= confirmFeatureCompatibilityVersion)
+		if err != nil {
+			return err
+		}
+
+		members, err = dataBearingMembers(ctx, c)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, host := range members {
+		op := executor.Operation{Name: "wait for feature compatibility version", Mode: executor.ConnectDirect, Host: host}
+
+		err := r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+			return waitForFeatureCompatibilityVersion(ctx, c, host, plan.Version)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getFeatureCompatibilityVersion returns the featureCompatibilityVersion parameter.
+func getFeatureCompatibilityVersion(ctx context.Context, client *mongo.Client) (featureCompatibilityVersion, error) {
+	reply := struct {
+		FeatureCompatibilityVersion featureCompatibilityVersion `bson:"featureCompatibilityVersion"`
+	}{}
+
+	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+		{Key: "getParameter", Value: 1},
+		{Key: "featureCompatibilityVersion", Value: 1},
+	}).Decode(&reply)
+	if err != nil {
+		return reply.FeatureCompatibilityVersion, fmt.Errorf("getParameter failed with error: %w", err)
+	}
+
+	return reply.FeatureCompatibilityVersion, nil
+}
+
+// setFeatureCompatibilityVersion sets the version. MongoDB 7.0 and later require the change to be confirmed.
+func setFeatureCompatibilityVersion(ctx context.Context, client *mongo.Client, version string, confirm bool) error {
+	cmd := bson.D{{Key: "setFeatureCompatibilityVersion", Value: version}}
+	if confirm {
+		cmd = append(cmd, bson.E{Key: "confirm", Value: true})
+	}
+
+	err := client.Database(types.DefaultDatabase).RunCommand(ctx, cmd).Err()
+	if err != nil {
+		return fmt.Errorf("setFeatureCompatibilityVersion failed with error: %w", err)
+	}
+
+	return nil
+}
+
+// waitForFeatureCompatibilityVersion polls the member until it reports the version without a transition in progress.
+// A member still in transition at the deadline is reported with the version and target it last returned,
+// which tells an interrupted upgrade or downgrade apart from an unreachable member.
+func waitForFeatureCompatibilityVersion(ctx context.Context, client *mongo.Client, host, version string) error {
+	ticker := time.NewTicker(featureCompatibilityVersionPollingInterval)
+	defer ticker.Stop()
+
+	var last *featureCompatibilityVersion
+
+	for {
+		current, err := getFeatureCompatibilityVersion(ctx, client)
+		if last != nil && errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("feature compatibility version of %s is %s, expected %s: %w", host, describeFeatureCompatibilityVersion(*last), version, context.DeadlineExceeded)
+		}
+		if err != nil {
+			return err
+		}
+
+		if current.Version == version && current.TargetVersion == "" {
+			return nil
+		}
+
+		last = &current
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("feature compatibility version of %s is %s, expected %s: %w", host, describeFeatureCompatibilityVersion(current), version, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// describeFeatureCompatibilityVersion returns the version with the target of a transition in progress.
+func describeFeatureCompatibilityVersion(v featureCompatibilityVersion) string {
+	if v.TargetVersion != "" {
+		return fmt.Sprintf("%s transitioning to %s", v.Version, v.TargetVersion)
+	}
+
+	return v.Version
+}
+
+// dataBearingMembers returns the members of the replica set except arbiters, or nothing when the client
+// is connected to a standalone server or a mongos.
+func dataBearingMembers(ctx context.Context, client *mongo.Client) ([]string, error) {
+	var hello struct {
+		SetName string `bson:"setName"`
+	}
+
+	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server type: %w", err)
+	}
+
+	if hello.SetName == "" {
+		return nil, nil
+	}
+
+	rsc, err := getReplicaSetConfig(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []string
+
+	for _, m := range rsc.Config.Members {
+		if m.ArbiterOnly == nil || !*m.ArbiterOnly {
+			members = append(members, m.Host)
+		}
+	}
+
+	return members, nil
+}
+
+// serverMajorVersion returns the major version of the server binary.
+func serverMajorVersion(ctx context.Context, client *mongo.Client) (int, error) {
+	v, err := serverVersion(ctx, client)
+	if err != nil {
+		return 0, err
+	}
+
+	return v[0], nil
+}
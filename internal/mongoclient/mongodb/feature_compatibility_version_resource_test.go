@@ -0,0 +1,219 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// fcv returns a getParameter handler reporting the feature compatibility version and the target of a transition.
+func fcv(version, targetVersion string) mongotest.Handler {
+	v := bson.D{{Key: "version", Value: version}}
+	if targetVersion != "" {
+		v = append(v, bson.E{Key: "targetVersion", Value: targetVersion})
+	}
+
+	return mongotest.Reply(bson.E{Key: "featureCompatibilityVersion", Value: v})
+}
+
+// setFCVPollingInterval speeds up waitForFeatureCompatibilityVersion for the test.
+func setFCVPollingInterval(t *testing.T, d time.Duration) {
+	t.Helper()
+
+	prev := featureCompatibilityVersionPollingInterval
+	featureCompatibilityVersionPollingInterval = d
+
+	t.Cleanup(func() {
+		featureCompatibilityVersionPollingInterval = prev
+	})
+}
+
+func TestResourceFeatureCompatibilityVersionCreate(t *testing.T) {
+	allowDowngrade := true
+
+	tests := []struct {
+		name        string
+		build       bson.A
+		current     string
+		plan        types.FeatureCompatibilityVersion
+		wantConfirm bool
+		wantErr     string
+	}{
+		{
+			name:    "upgrade on MongoDB 6",
+			build:   bson.A{6, 0, 5, 0},
+			current: "5.0",
+			plan:    types.FeatureCompatibilityVersion{Version: "6.0"},
+		},
+		{
+			name:        "upgrade on MongoDB 7 is confirmed",
+			build:       bson.A{7, 0, 2, 0},
+			current:     "6.0",
+			plan:        types.FeatureCompatibilityVersion{Version: "7.0"},
+			wantConfirm: true,
+		},
+		{
+			name:    "downgrade is refused",
+			build:   bson.A{7, 0, 2, 0},
+			current: "7.0",
+			plan:    types.FeatureCompatibilityVersion{Version: "6.0"},
+			wantErr: "requires allow_downgrade",
+		},
+		{
+			name:        "downgrade is allowed",
+			build:       bson.A{7, 0, 2, 0},
+			current:     "7.0",
+			plan:        types.FeatureCompatibilityVersion{Version: "6.0", AllowDowngrade: &allowDowngrade},
+			wantConfirm: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.Handle("buildInfo", mongotest.Reply(bson.E{Key: "version", Value: "x"}, bson.E{Key: "versionArray", Value: tt.build}))
+			srv.Handle("getParameter", fcv(tt.current, ""))
+
+			r := &ResourceFeatureCompatibilityVersion{Executor: newTestExecutor(srv)}
+
+			err := r.Create(context.Background(), tt.plan)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+
+				if srv.Calls("setFeatureCompatibilityVersion") != 0 {
+					t.Fatal("expected no setFeatureCompatibilityVersion")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			cmd := srv.Commands("setFeatureCompatibilityVersion")[0]
+			if v := lookup(t, cmd, "setFeatureCompatibilityVersion"); v.StringValue() != tt.plan.Version {
+				t.Fatalf("expected version %s, got %s", tt.plan.Version, v)
+			}
+
+			_, err = cmd.LookupErr("confirm")
+			if confirmed := err == nil; confirmed != tt.wantConfirm {
+				t.Fatalf("expected confirm %t, got %t", tt.wantConfirm, confirmed)
+			}
+		})
+	}
+}
+
+func TestResourceFeatureCompatibilityVersionWaitsForMembers(t *testing.T) {
+	setFCVPollingInterval(t, 10*time.Millisecond)
+
+	primary := mongotest.NewServer(t)
+	secondary := mongotest.NewServer(t)
+
+	primary.SetReplicaSet("rs0")
+	primary.Handle("replSetGetConfig", mongotest.Reply(bson.E{Key: "config", Value: bson.D{
+		{Key: "_id", Value: "rs0"},
+		{Key: "version", Value: 1},
+		{Key: "members", Value: bson.A{
+			bson.D{{Key: "_id", Value: 0}, {Key: "host", Value: primary.Addr}},
+			bson.D{{Key: "_id", Value: 1}, {Key: "host", Value: secondary.Addr}},
+			bson.D{{Key: "_id", Value: 2}, {Key: "host", Value: "arbiter:27017"}, {Key: "arbiterOnly", Value: true}},
+		}},
+	}}))
+	secondary.Handle("getParameter", fcv("6.0", ""))
+	secondary.Script("getParameter", fcv("5.0", "6.0"), fcv("5.0", "6.0"))
+
+	r := &ResourceFeatureCompatibilityVersion{Executor: newTestExecutor(primary)}
+
+	err := r.Create(context.Background(), types.FeatureCompatibilityVersion{Version: "6.0"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if n := secondary.Calls("getParameter"); n != 3 {
+		t.Fatalf("expected the secondary polled until the transition completed, got %d calls", n)
+	}
+}
+
+func TestResourceFeatureCompatibilityVersionMongos(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+	srv.Handle("getParameter", fcv("5.0", ""))
+
+	r := &ResourceFeatureCompatibilityVersion{Executor: newTestExecutor(srv)}
+
+	err := r.Create(context.Background(), types.FeatureCompatibilityVersion{Version: "6.0"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if srv.Calls("setFeatureCompatibilityVersion") != 1 {
+		t.Fatal("expected the version set through the mongos")
+	}
+
+	if n := srv.Calls("getParameter") + srv.Calls("replSetGetConfig"); n != 1 {
+		t.Fatalf("expected no members polled through a mongos, got %d calls", n)
+	}
+}
+
+func TestResourceFeatureCompatibilityVersionWaitTimeout(t *testing.T) {
+	setFCVPollingInterval(t, 10*time.Millisecond)
+
+	srv := mongotest.NewServer(t)
+	srv.SetReplicaSet("rs0")
+	srv.Handle("replSetGetConfig", mongotest.Reply(bson.E{Key: "config", Value: bson.D{
+		{Key: "_id", Value: "rs0"},
+		{Key: "version", Value: 1},
+		{Key: "members", Value: bson.A{bson.D{{Key: "_id", Value: 0}, {Key: "host", Value: srv.Addr}}}},
+	}}))
+	srv.Handle("getParameter", fcv("5.0", "6.0"))
+
+	r := &ResourceFeatureCompatibilityVersion{Executor: newTestExecutor(srv)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	err := r.Create(ctx, types.FeatureCompatibilityVersion{Version: "6.0"})
+	if err == nil || !strings.Contains(err.Error(), "5.0 transitioning to 6.0") {
+		t.Fatalf("expected transition error, got %v", err)
+	}
+}
+
+func TestResourceFeatureCompatibilityVersionRead(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("getParameter", fcv("6.0", "7.0"))
+
+	r := &ResourceFeatureCompatibilityVersion{Executor: newTestExecutor(srv)}
+
+	got, err := r.Read(context.Background(), types.FeatureCompatibilityVersion{Version: "7.0"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Version != "6.0" {
+		t.Fatalf("expected the version of an incomplete upgrade to be 6.0, got %s", got.Version)
+	}
+}
+
+func TestResourceFeatureCompatibilityVersionImportState(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceFeatureCompatibilityVersion{Executor: newTestExecutor(srv)}
+
+	got, err := r.ImportState(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Version != "6.0" {
+		t.Fatalf("expected version 6.0, got %s", got.Version)
+	}
+}
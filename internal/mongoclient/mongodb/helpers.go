@@ -1,13 +1,17 @@
 package mongodb
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 	"slices"
 	"strings"
 	"time"
 
+	"terraform-provider-mongodb/internal/mongoclient/executor"
 	"terraform-provider-mongodb/internal/mongoclient/types"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -16,16 +20,128 @@ import (
 
 /* DATABASES */
 
-// createDatabase creates a new database in the MongoDB.
-func createDatabase(ctx context.Context, client *mongo.Client, name string) error {
-	db := client.Database(name)
-	collection := db.Collection("created_by_terraform")
-	document := bson.D{{"created_at", time.Now().Format(time.RFC850)}}
+// createDatabase creates a new database in the MongoDB with the collections. MongoDB creates a database
+// with its first collection, so without collections the database is materialised by inserting a document
+// into the marker collection.
+func createDatabase(ctx context.Context, client *mongo.Client, d types.Database) error {
+	db := client.Database(d.Name)
+
+	for _, name := range d.Collections {
+		err := createCollection(ctx, db, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !d.NeedsMarker() {
+		return nil
+	}
+
+	document := bson.D{{Key: "created_at", Value: time.Now().Format(time.RFC850)}}
+
+	_, err := db.Collection(d.Marker()).InsertOne(ctx, document)
 
-	_, err := collection.InsertOne(ctx, document)
 	return err
 }
 
+// createCollection creates the collection unless it exists, so it is safe to retry.
+func createCollection(ctx context.Context, db *mongo.Database, name string) error {
+	err := db.CreateCollection(ctx, name)
+
+	// NamespaceExists
+	var commandErr mongo.CommandError
+	if errors.As(err, &commandErr) && commandErr.Code == 48 {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to create collection %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// markerRemovable reports whether the marker collection of the database exists next to another collection,
+// so that dropping it keeps the database.
+func markerRemovable(ctx context.Context, client *mongo.Client, name, marker string) (bool, error) {
+	collections, err := client.Database(name).ListCollectionNames(ctx, bson.D{})
+	if err != nil {
+		return false, fmt.Errorf("listCollections failed with error: %w", err)
+	}
+
+	if !slices.Contains(collections, marker) {
+		return false, nil
+	}
+
+	others := slices.ContainsFunc(collections, func(c string) bool {
+		return c != marker && !strings.HasPrefix(c, "system.")
+	})
+
+	return others, nil
+}
+
+// removeMarker drops the marker collection of the database if it has another collection, which keeps
+// the database when the marker is gone.
+func removeMarker(ctx context.Context, client *mongo.Client, name, marker string) error {
+	removable, err := markerRemovable(ctx, client, name, marker)
+	if err != nil || !removable {
+		return err
+	}
+
+	return dropCollection(ctx, client, name, marker)
+}
+
+// requireEmptyDatabase returns an unrecoverable error if a collection of the database other than the marker
+// and the system collections holds documents. Views are skipped, as they hold no documents of their own.
+func requireEmptyDatabase(ctx context.Context, client *mongo.Client, name, marker string) error {
+	db := client.Database(name)
+
+	collections, err := db.ListCollectionNames(ctx, bson.D{{Key: "type", Value: "collection"}})
+	if err != nil {
+		return fmt.Errorf("listCollections failed with error: %w", err)
+	}
+
+	slices.Sort(collections)
+
+	var documents int64
+	var holding []string
+
+	for _, c := range collections {
+		if c == marker || strings.HasPrefix(c, "system.") {
+			continue
+		}
+
+		n, err := db.Collection(c).EstimatedDocumentCount(ctx)
+		if err != nil {
+			return fmt.Errorf("count failed with error: %w", err)
+		}
+
+		if n > 0 {
+			documents += n
+			holding = append(holding, c)
+		}
+	}
+
+	if documents > 0 {
+		return executor.Unrecoverable(fmt.Errorf("database %s holds %d documents in the collections %s and drop_only_if_empty is set, "+
+			"drop the collections first", name, documents, strings.Join(holding, ", ")))
+	}
+
+	return nil
+}
+
+// databaseStats returns the statistics of the database from dbStats, without the listDatabases fields.
+func databaseStats(ctx context.Context, client *mongo.Client, name string) (types.DatabaseStats, error) {
+	var stats types.DatabaseStats
+
+	err := client.Database(name).RunCommand(ctx, bson.D{{Key: "dbStats", Value: 1}}).Decode(&stats)
+	if err != nil {
+		return stats, fmt.Errorf("dbStats failed with error: %w", err)
+	}
+
+	return stats, nil
+}
+
 // databaseExists checks if the database already exists in the MongoDB.
 func databaseExists(ctx context.Context, client *mongo.Client, name string) (bool, error) {
 	d, err := client.ListDatabaseNames(
@@ -51,6 +167,102 @@ func isDefaultDatabase(name string) bool {
 	return slices.Contains(types.DefaultDatabases, name)
 }
 
+/* COLLECTIONS */
+
+// Collection types reported by listCollections.
+const (
+	collectionTypeCollection = "collection"
+	collectionTypeView       = "view"
+	collectionTypeTimeSeries = "timeseries"
+)
+
+// getCollectionOptions decodes the listCollections options of the collection into opts. It returns false
+// if there is no collection with the name, and an error if the collection is not of the collection type.
+func getCollectionOptions(ctx context.Context, client *mongo.Client, database, name, collectionType string, opts interface{}) (bool, error) {
+	specs, err := client.Database(database).ListCollectionSpecifications(ctx, bson.D{{Key: "name", Value: name}})
+	if err != nil {
+		return false, fmt.Errorf("listCollections failed with error: %w", err)
+	}
+
+	if len(specs) == 0 {
+		return false, nil
+	}
+
+	if specs[0].Type != collectionType {
+		return false, executor.Unrecoverable(fmt.Errorf("%s.%s is a %s, not a %s", database, name, specs[0].Type, collectionType))
+	}
+
+	err = bson.Unmarshal(specs[0].Options, opts)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode the options of %s %s.%s: %w", collectionType, database, name, err)
+	}
+
+	return true, nil
+}
+
+// dropCollection drops the collection or view.
+func dropCollection(ctx context.Context, client *mongo.Client, database, name string) error {
+	err := client.Database(database).RunCommand(ctx, bson.D{{Key: "drop", Value: name}}).Err()
+	if err != nil {
+		return fmt.Errorf("drop failed with error: %w", err)
+	}
+
+	return nil
+}
+
+// collectionStats are the storage statistics of a collection reported by $collStats.
+type collectionStats struct {
+	Count          int64            `bson:"count"`
+	Size           int64            `bson:"size"`
+	StorageSize    int64            `bson:"storageSize"`
+	TotalIndexSize int64            `bson:"totalIndexSize"`
+	Indexes        int64            `bson:"nindexes"`
+	IndexSizes     map[string]int64 `bson:"indexSizes"`
+}
+
+// getCollectionStats returns the storage statistics of the collection. $collStats returns a document
+// per shard for a sharded collection, the sizes are summed over the shards.
+func getCollectionStats(ctx context.Context, db *mongo.Database, name string) (collectionStats, error) {
+	stats := collectionStats{IndexSizes: map[string]int64{}}
+
+	cursor, err := db.Collection(name).Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$collStats", Value: bson.D{{Key: "storageStats", Value: bson.D{}}}}},
+	})
+	if err != nil {
+		return stats, fmt.Errorf("$collStats failed with error: %w", err)
+	}
+
+	var shards []struct {
+		StorageStats collectionStats `bson:"storageStats"`
+	}
+
+	err = cursor.All(ctx, &shards)
+	if err != nil {
+		return stats, fmt.Errorf("failed to decode the statistics of %s.%s: %w", db.Name(), name, err)
+	}
+
+	for _, shard := range shards {
+		stats.Count += shard.StorageStats.Count
+		stats.Size += shard.StorageStats.Size
+		stats.StorageSize += shard.StorageStats.StorageSize
+		stats.TotalIndexSize += shard.StorageStats.TotalIndexSize
+		stats.Indexes = max(stats.Indexes, shard.StorageStats.Indexes)
+
+		for index, size := range shard.StorageStats.IndexSizes {
+			stats.IndexSizes[index] += size
+		}
+	}
+
+	return stats, nil
+}
+
+// formatDocument returns the document in relaxed Extended JSON.
+func formatDocument(d bson.D) (string, error) {
+	b, err := bson.MarshalExtJSON(d, false, false)
+
+	return string(b), err
+}
+
 /* USERS */
 
 // listUsers returns a list of users in the database.
@@ -58,7 +270,7 @@ func listUsers(ctx context.Context, client *mongo.Client) (types.Users, error) {
 	r := types.Users{}
 
 	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
-		{"usersInfo", 1},
+		{Key: "usersInfo", Value: 1},
 	}).Decode(&r)
 
 	return r, err
@@ -86,7 +298,7 @@ func userExists(ctx context.Context, client *mongo.Client, username string) (boo
 func getReplicaSetStatus(ctx context.Context, client *mongo.Client) (*types.ReplicaSetStatus, error) {
 	status := types.ReplicaSetStatus{}
 
-	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{{"replSetGetStatus", 1}}).Decode(&status)
+	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{{Key: "replSetGetStatus", Value: 1}}).Decode(&status)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +311,7 @@ func getReplicaSetConfig(ctx context.Context, client *mongo.Client) (*types.Repl
 	rsc := types.ReplicaSetConfig{}
 
 	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
-		{"replSetGetConfig", 1},
+		{Key: "replSetGetConfig", Value: 1},
 	}).Decode(&rsc)
 
 	if err != nil {
@@ -107,15 +319,15 @@ func getReplicaSetConfig(ctx context.Context, client *mongo.Client) (*types.Repl
 
 		// NotYetInitialized
 		if errors.As(err, &commandErr) && commandErr.Code == 94 {
-			return &rsc, fmt.Errorf("replica set not initialized. Please create, plan and apply mongodb_replicaset resource first")
+			return &rsc, executor.Unrecoverable(fmt.Errorf("replica set not initialized. Please create, plan and apply mongodb_replicaset resource first"))
 		}
 
 		// NoReplicationEnabled
 		if errors.As(err, &commandErr) && commandErr.Code == 76 {
-			return &rsc, fmt.Errorf("replication not enabled. Please add replSetName in your mongod.conf file, then create, plan and apply mongodb_replicaset resource first")
+			return &rsc, executor.Unrecoverable(fmt.Errorf("replication not enabled. Please add replSetName in your mongod.conf file, then create, plan and apply mongodb_replicaset resource first"))
 		}
 
-		return &rsc, fmt.Errorf("get replica set config failed with error: %s", err)
+		return &rsc, fmt.Errorf("get replica set config failed with error: %w", err)
 	}
 
 	rsc.Config.ClearVersion()
@@ -168,6 +380,231 @@ func isReplicaSetReady(status *types.ReplicaSetStatus, replicaSetName string) bo
 	return hasPrimary && allMembersOk
 }
 
+/* SHARDING */
+
+// requireMongos checks that the client is connected to a mongos, because sharding commands
+// are not available on replica set members.
+func requireMongos(ctx context.Context, client *mongo.Client) error {
+	var hello struct {
+		Msg string `bson:"msg"`
+	}
+
+	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello)
+	if err != nil {
+		return fmt.Errorf("failed to get server type: %w", err)
+	}
+
+	if hello.Msg != "isdbgrid" {
+		return executor.Unrecoverable(fmt.Errorf("sharding is managed through mongos. Please use the connection string of a mongos router"))
+	}
+
+	return nil
+}
+
+// listShards returns the shards of the cluster.
+func listShards(ctx context.Context, client *mongo.Client) (types.Shards, error) {
+	s := types.Shards{}
+
+	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+		{Key: "listShards", Value: 1},
+	}).Decode(&s)
+
+	return s, err
+}
+
+// shardingCollection is the sharding metadata of a collection in config.collections.
+type shardingCollection struct {
+	ID     string `bson:"_id"`
+	Key    bson.D `bson:"key"`
+	Unique bool   `bson:"unique"`
+}
+
+// getShardedCollection returns the sharding metadata of the collection, or nil if it is not sharded.
+func getShardedCollection(ctx context.Context, client *mongo.Client, namespace string) (*shardingCollection, error) {
+	c := shardingCollection{}
+
+	err := client.Database(types.ConfigDatabase).Collection("collections").FindOne(ctx, bson.D{
+		{Key: "_id", Value: namespace},
+	}).Decode(&c)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// shardKeyDocument returns the key document of shardCollection and the other shard key commands.
+func shardKeyDocument(key types.ShardKey) bson.D {
+	d := bson.D{}
+
+	for _, f := range key {
+		if f.Type == types.ShardKeyHashed {
+			d = append(d, bson.E{Key: f.Field, Value: "hashed"})
+			continue
+		}
+
+		d = append(d, bson.E{Key: f.Field, Value: 1})
+	}
+
+	return d
+}
+
+// shardKeyFromDocument converts the key document of config.collections. Fields other than hashed are ascending,
+// because descending shard keys are not supported.
+func shardKeyFromDocument(d bson.D) types.ShardKey {
+	key := types.ShardKey{}
+
+	for _, e := range d {
+		t := types.ShardKeyAscending
+		if v, ok := e.Value.(string); ok && v == "hashed" {
+			t = types.ShardKeyHashed
+		}
+
+		key = append(key, types.ShardKeyField{Field: e.Key, Type: t})
+	}
+
+	return key
+}
+
+// splitNamespace splits the <database>.<collection> namespace. Database names can't contain dots,
+// so the collection is everything after the first one.
+func splitNamespace(namespace string) (string, string, bool) {
+	db, collection, ok := strings.Cut(namespace, ".")
+	if !ok || db == "" || collection == "" {
+		return "", "", false
+	}
+
+	return db, collection, true
+}
+
+// getShardZones returns the zones of the shard from config.shards. It returns false if the shard does not exist.
+func getShardZones(ctx context.Context, client *mongo.Client, shard string) ([]string, bool, error) {
+	var s struct {
+		Tags []string `bson:"tags"`
+	}
+
+	err := client.Database(types.ConfigDatabase).Collection("shards").FindOne(ctx, bson.D{
+		{Key: "_id", Value: shard},
+	}).Decode(&s)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return s.Tags, true, nil
+}
+
+// zoneRange is a zone key range in config.tags.
+type zoneRange struct {
+	Min bson.D `bson:"min"`
+	Max bson.D `bson:"max"`
+	Tag string `bson:"tag"`
+}
+
+// listZoneRanges returns the zone key ranges of the collection from config.tags.
+func listZoneRanges(ctx context.Context, client *mongo.Client, namespace string) ([]zoneRange, error) {
+	cursor, err := client.Database(types.ConfigDatabase).Collection("tags").Find(ctx, bson.D{
+		{Key: "ns", Value: namespace},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ranges := []zoneRange{}
+	err = cursor.All(ctx, &ranges)
+
+	return ranges, err
+}
+
+// findZoneRange returns the range starting at the lower bound, or nil if there is none. Ranges of a collection
+// can't overlap, so the lower bound identifies the range.
+func findZoneRange(ranges []zoneRange, lower bson.D) *zoneRange {
+	for _, r := range ranges {
+		if sameBound(r.Min, lower) {
+			return &r
+		}
+	}
+
+	return nil
+}
+
+// zoneRangeBounds returns the bounds of the range ordered as the shard key of its collection.
+func zoneRangeBounds(ctx context.Context, client *mongo.Client, r types.ZoneKeyRange) (bson.D, bson.D, error) {
+	collection, err := getShardedCollection(ctx, client, r.Namespace())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get sharding metadata: %w", err)
+	}
+
+	if collection == nil {
+		return nil, nil, executor.Unrecoverable(fmt.Errorf("collection %s is not sharded", r.Namespace()))
+	}
+
+	lower, err := parseZoneBound(r.Min, collection.Key)
+	if err != nil {
+		return nil, nil, executor.Unrecoverable(fmt.Errorf("invalid min of zone key range: %w", err))
+	}
+
+	upper, err := parseZoneBound(r.Max, collection.Key)
+	if err != nil {
+		return nil, nil, executor.Unrecoverable(fmt.Errorf("invalid max of zone key range: %w", err))
+	}
+
+	return lower, upper, nil
+}
+
+// parseZoneBound parses a bound in Extended JSON and orders its fields as the shard key,
+// because the bounds of updateZoneKeyRange must have exactly the shard key fields in the key order.
+func parseZoneBound(bound string, key bson.D) (bson.D, error) {
+	var d bson.D
+
+	err := bson.UnmarshalExtJSON([]byte(bound), false, &d)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a JSON document: %w", bound, err)
+	}
+
+	fields := make([]string, 0, len(key))
+	for _, e := range key {
+		fields = append(fields, e.Key)
+	}
+
+	if len(d) != len(key) {
+		return nil, fmt.Errorf("%s must have exactly the shard key fields %s", bound, strings.Join(fields, ", "))
+	}
+
+	ordered := bson.D{}
+
+	for _, field := range fields {
+		i := slices.IndexFunc(d, func(e bson.E) bool { return e.Key == field })
+		if i < 0 {
+			return nil, fmt.Errorf("%s must have exactly the shard key fields %s", bound, strings.Join(fields, ", "))
+		}
+
+		ordered = append(ordered, d[i])
+	}
+
+	return ordered, nil
+}
+
+// sameBound compares the bounds by their BSON encoding, so the values must have the same types.
+func sameBound(a, b bson.D) bool {
+	encodedA, errA := bson.Marshal(a)
+	encodedB, errB := bson.Marshal(b)
+
+	return errA == nil && errB == nil && bytes.Equal(encodedA, encodedB)
+}
+
+// formatZoneBound returns the bound in relaxed Extended JSON.
+func formatZoneBound(bound bson.D) (string, error) {
+	b, err := bson.MarshalExtJSON(bound, false, false)
+
+	return string(b), err
+}
+
 // requiredVersion checks if the current MongoDB version is supported by the provider.
 // This is necessary because different versions have different key names for the replica set configuration.
 func requiredVersion(ctx context.Context, client *mongo.Client) error {
@@ -175,16 +612,90 @@ func requiredVersion(ctx context.Context, client *mongo.Client) error {
 		Version string `bson:"version"`
 	}
 
-	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{{"buildInfo", 1}}).Decode(&v)
+	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{{Key: "buildInfo", Value: 1}}).Decode(&v)
 	if err != nil {
-		return fmt.Errorf("failed to get MongoDB version: %s", err)
+		return fmt.Errorf("failed to get MongoDB version: %w", err)
 	}
 
 	vPrefix := fmt.Sprintf("%s.", types.MongoDBRequiredVersion)
 
 	if !strings.HasPrefix(v.Version, vPrefix) {
-		return fmt.Errorf("unsupported MongoDB version. Current version is %s, but provider required only %s version", v.Version, types.MongoDBRequiredVersion)
+		return executor.Unrecoverable(fmt.Errorf("unsupported MongoDB version. Current version is %s, but provider required only %s version", v.Version, types.MongoDBRequiredVersion))
+	}
+
+	return nil
+}
+
+// serverVersion returns the version of the server binary as reported by the versionArray of buildInfo, e.g. [6, 0, 5, 0].
+func serverVersion(ctx context.Context, client *mongo.Client) ([]int, error) {
+	var v struct {
+		VersionArray []int `bson:"versionArray"`
+	}
+
+	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{{Key: "buildInfo", Value: 1}}).Decode(&v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get MongoDB version: %w", err)
+	}
+
+	if len(v.VersionArray) < 2 {
+		return nil, fmt.Errorf("failed to get MongoDB version: buildInfo has no versionArray")
+	}
+
+	return v.VersionArray, nil
+}
+
+// requireServerVersion checks if the server is at least the major.minor version required by the feature.
+func requireServerVersion(ctx context.Context, client *mongo.Client, major, minor int, feature string) error {
+	v, err := serverVersion(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if v[0] < major || v[0] == major && v[1] < minor {
+		return executor.Unrecoverable(fmt.Errorf("%s requires MongoDB %d.%d or later, the server runs %d.%d", feature, major, minor, v[0], v[1]))
 	}
 
 	return nil
 }
+
+/* EXTENDED JSON */
+
+// parseExtJSON parses a value in Extended JSON, e.g. 2, "debug", true or {"$numberLong": "2"}.
+// Integers are int32 if they fit, like in the shell.
+func parseExtJSON(value string) (bson.RawValue, error) {
+	var d bson.Raw
+
+	err := bson.UnmarshalExtJSON([]byte(`{"v":`+value+`}`), false, &d)
+	if err != nil {
+		return bson.RawValue{}, fmt.Errorf("%s is not a JSON value: %w", value, err)
+	}
+
+	return d.Lookup("v"), nil
+}
+
+// formatExtJSON returns the value in relaxed Extended JSON.
+func formatExtJSON(value bson.RawValue) (string, error) {
+	b, err := bson.MarshalExtJSON(bson.D{{Key: "v", Value: value}}, false, false)
+	if err != nil {
+		return "", err
+	}
+
+	var wrapped struct {
+		V json.RawMessage `json:"v"`
+	}
+
+	err = json.Unmarshal(b, &wrapped)
+
+	return string(wrapped.V), err
+}
+
+// sameExtJSON compares the values as JSON, so numbers of different types with the same value are equal.
+func sameExtJSON(a, b string) bool {
+	var valueA, valueB interface{}
+
+	if json.Unmarshal([]byte(a), &valueA) != nil || json.Unmarshal([]byte(b), &valueB) != nil {
+		return a == b
+	}
+
+	return reflect.DeepEqual(valueA, valueB)
+}
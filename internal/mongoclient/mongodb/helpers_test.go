@@ -0,0 +1,100 @@
+package mongodb
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestParseExtJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  bson.Type
+	}{
+		{name: "int32", value: `2`, want: bson.TypeInt32},
+		{name: "int64 beyond int32", value: `3000000000`, want: bson.TypeInt64},
+		{name: "explicit int64", value: `{"$numberLong": "2"}`, want: bson.TypeInt64},
+		{name: "double", value: `2.5`, want: bson.TypeDouble},
+		{name: "string", value: `"debug"`, want: bson.TypeString},
+		{name: "boolean", value: `true`, want: bson.TypeBoolean},
+		{name: "document", value: `{"a": 1}`, want: bson.TypeEmbeddedDocument},
+		{name: "array", value: `[{"$match": {}}]`, want: bson.TypeArray},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseExtJSON(tt.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.Type != tt.want {
+				t.Fatalf("expected type %s, got %s", tt.want, got.Type)
+			}
+		})
+	}
+}
+
+func TestParseExtJSONInvalid(t *testing.T) {
+	_, err := parseExtJSON(`debug`)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestFormatExtJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "int32", value: `2`, want: `2`},
+		{name: "int64", value: `{"$numberLong": "2"}`, want: `2`},
+		{name: "string", value: `"debug"`, want: `"debug"`},
+		{name: "document", value: `{"b": 1, "a": "x"}`, want: `{"b":1,"a":"x"}`},
+		{name: "array", value: `[{"$match": {"a": true}}]`, want: `[{"$match":{"a":true}}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := parseExtJSON(tt.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := formatExtJSON(value)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != tt.want {
+				t.Fatalf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSameExtJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{name: "same number of another type", a: `2`, b: `2.0`, want: true},
+		{name: "different numbers", a: `2`, b: `3`, want: false},
+		{name: "fields in another order", a: `{"a":1,"b":2}`, b: `{"b":2,"a":1}`, want: true},
+		{name: "elements in another order", a: `[1,2]`, b: `[2,1]`, want: false},
+		{name: "spacing", a: `{"a": 1}`, b: `{"a":1}`, want: true},
+		{name: "invalid JSON compared as text", a: `debug`, b: `debug`, want: true},
+		{name: "invalid JSON with another text", a: `debug`, b: `info`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameExtJSON(tt.a, tt.b); got != tt.want {
+				t.Fatalf("expected %t for %s and %s, got %t", tt.want, tt.a, tt.b, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Read lists the indexes of the collection, or of all collections of the database without the system
+// collections, with their sizes from $collStats. The name regex is evaluated on the server.
+func (d *DataSourceIndex) Read(ctx context.Context, config types.Indexes) (types.Indexes, error) {
+	op := executor.Operation{Name: "read indexes"}
+
+	err := d.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		db := c.Database(config.Database)
+		config.Indexes = []types.Index{}
+
+		var collections []string
+
+		if config.Collection != nil {
+			collections = []string{*config.Collection}
+		} else {
+			names, err := db.ListCollectionNames(ctx, bson.D{{Key: "type", Value: bson.D{
+				{Key: "$in", Value: bson.A{collectionTypeCollection, collectionTypeTimeSeries}},
+			}}})
+			if err != nil {
+				return fmt.Errorf("listCollections failed with error: %w", err)
+			}
+
+			for _, name := range names {
+				if !strings.HasPrefix(name, "system.") {
+					collections = append(collections, name)
+				}
+			}
+
+			slices.Sort(collections)
+		}
+
+		for _, collection := range collections {
+			indexes, err := listIndexes(ctx, db, collection, config.NameRegex)
+			if err != nil {
+				return err
+			}
+
+			config.Indexes = append(config.Indexes, indexes...)
+		}
+
+		return nil
+	})
+
+	return config, err
+}
+
+// listIndexes returns the indexes of the collection matching the optional regex in the listIndexes order, with their sizes.
+// The driver ignores the NamespaceNotFound error of listIndexes, a collection which does not exist has no indexes.
+func listIndexes(ctx context.Context, db *mongo.Database, collection string, regex *string) ([]types.Index, error) {
+	cursor, err := db.Collection(collection).Indexes().List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listIndexes failed with error: %w", err)
+	}
+
+	var specs []bson.D
+
+	err = cursor.All(ctx, &specs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the indexes of %s.%s: %w", db.Name(), collection, err)
+	}
+
+	var indexes []types.Index
+
+	for _, spec := range specs {
+		index, err := indexFromSpecification(collection, spec)
+		if err != nil {
+			return nil, executor.Unrecoverable(fmt.Errorf("failed to decode an index of %s.%s: %w", db.Name(), collection, err))
+		}
+
+		indexes = append(indexes, index)
+	}
+
+	if regex != nil && len(indexes) > 0 {
+		indexes, err = matchIndexNames(ctx, db, indexes, *regex)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(indexes) == 0 {
+		return nil, nil
+	}
+
+	stats, err := getCollectionStats(ctx, db, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range indexes {
+		indexes[i].Size = stats.IndexSizes[indexes[i].Name]
+	}
+
+	return indexes, nil
+}
+
+// matchIndexNames returns the indexes whose name matches the regex. listIndexes has no filter, so the names
+// are matched with $regex in a $documents pipeline, like the name filter of the other data sources.
+func matchIndexNames(ctx context.Context, db *mongo.Database, indexes []types.Index, regex string) ([]types.Index, error) {
+	documents := bson.A{}
+	for _, index := range indexes {
+		documents = append(documents, bson.D{{Key: "name", Value: index.Name}})
+	}
+
+	cursor, err := db.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$documents", Value: documents}},
+		{{Key: "$match", Value: bson.D{{Key: "name", Value: bson.D{{Key: "$regex", Value: regex}}}}}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to match the index names: %w", err)
+	}
+
+	var matched []struct {
+		Name string `bson:"name"`
+	}
+
+	err = cursor.All(ctx, &matched)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the matching index names: %w", err)
+	}
+
+	names := make(map[string]bool, len(matched))
+	for _, m := range matched {
+		names[m.Name] = true
+	}
+
+	return slices.DeleteFunc(indexes, func(index types.Index) bool {
+		return !names[index.Name]
+	}), nil
+}
+
+// indexFromSpecification returns the index of the listIndexes specification. The options are
+// the fields other than the key, the name and the index version.
+func indexFromSpecification(collection string, spec bson.D) (types.Index, error) {
+	index := types.Index{Collection: collection}
+	options := bson.D{}
+
+	for _, e := range spec {
+		switch e.Key {
+		case "name":
+			index.Name, _ = e.Value.(string)
+		case "key":
+			key, ok := e.Value.(bson.D)
+			if !ok {
+				return index, fmt.Errorf("key is not a document")
+			}
+
+			formatted, err := formatDocument(key)
+			if err != nil {
+				return index, err
+			}
+
+			index.Key = formatted
+		case "v":
+		default:
+			options = append(options, e)
+		}
+	}
+
+	formatted, err := formatDocument(options)
+	if err != nil {
+		return index, err
+	}
+
+	index.Options = formatted
+
+	return index, nil
+}
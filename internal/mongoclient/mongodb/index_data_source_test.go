@@ -0,0 +1,124 @@
+package mongodb
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestDataSourceIndexRead(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("listCollections", mongotest.Cursor(
+		bson.D{{Key: "name", Value: "sessions"}, {Key: "type", Value: "collection"}},
+		bson.D{{Key: "name", Value: "orders"}, {Key: "type", Value: "collection"}},
+		bson.D{{Key: "name", Value: "system.profile"}, {Key: "type", Value: "collection"}},
+	))
+	srv.Handle("listIndexes", mongotest.Collections(map[string][]bson.D{
+		"app.orders": {
+			{{Key: "v", Value: 2}, {Key: "key", Value: bson.D{{Key: "_id", Value: 1}}}, {Key: "name", Value: "_id_"}},
+			{{Key: "v", Value: 2}, {Key: "key", Value: bson.D{{Key: "tenant_id", Value: 1}, {Key: "created_at", Value: -1}}}, {Key: "name", Value: "tenant_created"}, {Key: "unique", Value: true}},
+		},
+		"app.sessions": {
+			{{Key: "v", Value: 2}, {Key: "key", Value: bson.D{{Key: "_id", Value: 1}}}, {Key: "name", Value: "_id_"}},
+		},
+	}))
+	srv.Handle("aggregate", mongotest.Collections(map[string][]bson.D{
+		"app.orders": {
+			{{Key: "storageStats", Value: bson.D{{Key: "indexSizes", Value: bson.D{{Key: "_id_", Value: int32(4096)}, {Key: "tenant_created", Value: int32(8192)}}}}}},
+		},
+	}))
+
+	d := &DataSourceIndex{Executor: newTestExecutor(srv)}
+
+	got, err := d.Read(context.Background(), types.Indexes{Database: "app"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got.Indexes) != 3 || got.Indexes[0].Collection != "orders" || got.Indexes[2].Collection != "sessions" {
+		t.Fatalf("expected the indexes of orders and sessions, got %+v", got.Indexes)
+	}
+
+	want := types.Index{
+		Collection: "orders",
+		Name:       "tenant_created",
+		Key:        `{"tenant_id":1,"created_at":-1}`,
+		Options:    `{"unique":true}`,
+		Size:       8192,
+	}
+	if got.Indexes[1] != want {
+		t.Fatalf("expected index %+v, got %+v", want, got.Indexes[1])
+	}
+
+	if got.Indexes[0].Options != "{}" || got.Indexes[0].Size != 4096 {
+		t.Fatalf("expected the _id_ index without options, got %+v", got.Indexes[0])
+	}
+
+	if calls := srv.Calls("listIndexes"); calls != 2 {
+		t.Fatalf("expected no listIndexes of the system collections, got %d calls", calls)
+	}
+}
+
+func TestDataSourceIndexReadNameRegex(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("listIndexes", mongotest.Cursor(
+		bson.D{{Key: "v", Value: 2}, {Key: "key", Value: bson.D{{Key: "_id", Value: 1}}}, {Key: "name", Value: "_id_"}},
+		bson.D{{Key: "v", Value: 2}, {Key: "key", Value: bson.D{{Key: "expires_at", Value: 1}}}, {Key: "name", Value: "ttl_expires_at"}},
+	))
+	srv.Handle("aggregate", mongotest.Collections(map[string][]bson.D{
+		"app.$cmd.aggregate": {{{Key: "name", Value: "ttl_expires_at"}}},
+		"app.orders": {
+			{{Key: "storageStats", Value: bson.D{{Key: "indexSizes", Value: bson.D{{Key: "_id_", Value: int32(4096)}, {Key: "ttl_expires_at", Value: int32(2048)}}}}}},
+		},
+	}))
+
+	d := &DataSourceIndex{Executor: newTestExecutor(srv)}
+
+	collection, regex := "orders", "(?i)^TTL_"
+
+	got, err := d.Read(context.Background(), types.Indexes{Database: "app", Collection: &collection, NameRegex: &regex})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got.Indexes) != 1 || got.Indexes[0].Name != "ttl_expires_at" || got.Indexes[0].Size != 2048 {
+		t.Fatalf("expected the ttl_expires_at index matched by the server, got %+v", got.Indexes)
+	}
+
+	match := srv.Commands("aggregate")[0]
+	if v := lookup(t, match, "pipeline", "1", "$match", "name", "$regex").StringValue(); v != regex {
+		t.Fatalf("expected the regex %s sent to the server, got %s", regex, v)
+	}
+
+	if names, _ := lookup(t, match, "pipeline", "0", "$documents").Array().Values(); len(names) != 2 {
+		t.Fatalf("expected the names of both indexes matched, got %v", names)
+	}
+}
+
+func TestDataSourceIndexReadNameRegexNoMatch(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("listIndexes", mongotest.Cursor(
+		bson.D{{Key: "v", Value: 2}, {Key: "key", Value: bson.D{{Key: "_id", Value: 1}}}, {Key: "name", Value: "_id_"}},
+	))
+
+	d := &DataSourceIndex{Executor: newTestExecutor(srv)}
+
+	collection, regex := "orders", "^ttl_"
+
+	got, err := d.Read(context.Background(), types.Indexes{Database: "app", Collection: &collection, NameRegex: &regex})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Indexes == nil || len(got.Indexes) != 0 || srv.Calls("listCollections") != 0 {
+		t.Fatalf("expected an empty list of the indexes of orders, got %+v", got.Indexes)
+	}
+
+	if calls := srv.Calls("aggregate"); calls != 1 {
+		t.Fatalf("expected only the name match and no $collStats, got %d aggregate calls", calls)
+	}
+}
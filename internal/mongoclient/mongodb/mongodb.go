@@ -3,126 +3,257 @@ package mongodb
 import (
 	"time"
 
+	"terraform-provider-mongodb/internal/mongoclient/executor"
 	"terraform-provider-mongodb/internal/mongoclient/interfaces"
 )
 
 var (
-	defaultContextTimeout     = 1 * time.Second
-	replicaSetPollingInterval = 5 * time.Second
+	replicaSetPollingInterval   = 5 * time.Second
+	shardRemovalPollingInterval = 5 * time.Second
+
+	featureCompatibilityVersionPollingInterval = 5 * time.Second
 )
 
 /* DATA SOURCE */
 
 type DataSource struct {
-	Uri           string
-	RetryAttempts uint
-	RetryDelay    time.Duration
+	Executor *executor.Executor
 }
 
 type DataSourceDatabase struct {
-	Uri           string
-	RetryAttempts uint
-	RetryDelay    time.Duration
+	Executor *executor.Executor
 }
 
 type DataSourceUser struct {
-	Uri           string
-	RetryAttempts uint
-	RetryDelay    time.Duration
+	Executor *executor.Executor
 }
 
 type DataSourceReplicaSet struct {
-	Uri           string
-	RetryAttempts uint
-	RetryDelay    time.Duration
+	Executor *executor.Executor
+}
+
+type DataSourceSharding struct {
+	Executor *executor.Executor
+}
+
+type DataSourceCollection struct {
+	Executor *executor.Executor
+}
+
+type DataSourceIndex struct {
+	Executor *executor.Executor
 }
 
 func (d *DataSource) DataSource() interfaces.DataSource {
 	return &DataSource{
-		Uri:           d.Uri,
-		RetryAttempts: d.RetryAttempts,
-		RetryDelay:    d.RetryDelay,
+		Executor: d.Executor,
 	}
 }
 
 func (d *DataSource) User() interfaces.DataSourceUser {
 	return &DataSourceUser{
-		Uri:           d.Uri,
-		RetryAttempts: d.RetryAttempts,
-		RetryDelay:    d.RetryDelay,
+		Executor: d.Executor,
 	}
 }
 
 func (d *DataSource) Database() interfaces.DataSourceDatabase {
 	return &DataSourceDatabase{
-		Uri:           d.Uri,
-		RetryAttempts: d.RetryAttempts,
-		RetryDelay:    d.RetryDelay,
+		Executor: d.Executor,
 	}
 }
 
 func (d *DataSource) ReplicaSet() interfaces.DataSourceReplicaSet {
 	return &DataSourceReplicaSet{
-		Uri:           d.Uri,
-		RetryAttempts: d.RetryAttempts,
-		RetryDelay:    d.RetryDelay,
+		Executor: d.Executor,
+	}
+}
+
+func (d *DataSource) Sharding() interfaces.DataSourceSharding {
+	return &DataSourceSharding{
+		Executor: d.Executor,
+	}
+}
+
+func (d *DataSource) Collection() interfaces.DataSourceCollection {
+	return &DataSourceCollection{
+		Executor: d.Executor,
+	}
+}
+
+func (d *DataSource) Index() interfaces.DataSourceIndex {
+	return &DataSourceIndex{
+		Executor: d.Executor,
 	}
 }
 
 /* RESOURCE */
 
 type Resource struct {
-	Uri           string
-	RetryAttempts uint
-	RetryDelay    time.Duration
+	Executor *executor.Executor
 }
 
 type ResourceDatabase struct {
-	Uri           string
-	RetryAttempts uint
-	RetryDelay    time.Duration
+	Executor *executor.Executor
 }
 
 type ResourceUser struct {
-	Uri           string
-	RetryAttempts uint
-	RetryDelay    time.Duration
+	Executor *executor.Executor
 }
 
 type ResourceReplicaSet struct {
-	Uri           string
-	RetryAttempts uint
-	RetryDelay    time.Duration
+	Executor *executor.Executor
+}
+
+type ResourceShard struct {
+	Executor *executor.Executor
+}
+
+type ResourceShardedCollection struct {
+	Executor *executor.Executor
+}
+
+type ResourceShardZone struct {
+	Executor *executor.Executor
+}
+
+type ResourceZoneKeyRange struct {
+	Executor *executor.Executor
+}
+
+type ResourceBalancer struct {
+	Executor *executor.Executor
+}
+
+type ResourceDefaultRWConcern struct {
+	Executor *executor.Executor
+}
+
+type ResourceServerParameter struct {
+	Executor *executor.Executor
+}
+
+type ResourceClusterParameter struct {
+	Executor *executor.Executor
+}
+
+type ResourceFeatureCompatibilityVersion struct {
+	Executor *executor.Executor
+}
+
+type ResourceProfiler struct {
+	Executor *executor.Executor
+}
+
+type ResourceView struct {
+	Executor *executor.Executor
+}
+
+type ResourceTimeSeriesCollection struct {
+	Executor *executor.Executor
+}
+
+type ResourceClusteredCollection struct {
+	Executor *executor.Executor
 }
 
 func (r *Resource) Resource() interfaces.Resource {
 	return &Resource{
-		Uri:           r.Uri,
-		RetryAttempts: r.RetryAttempts,
-		RetryDelay:    r.RetryDelay,
+		Executor: r.Executor,
 	}
 }
 
 func (r *Resource) User() interfaces.ResourceUser {
 	return &ResourceUser{
-		Uri:           r.Uri,
-		RetryAttempts: r.RetryAttempts,
-		RetryDelay:    r.RetryDelay,
+		Executor: r.Executor,
 	}
 }
 
 func (r *Resource) Database() interfaces.ResourceDatabase {
 	return &ResourceDatabase{
-		Uri:           r.Uri,
-		RetryAttempts: r.RetryAttempts,
-		RetryDelay:    r.RetryDelay,
+		Executor: r.Executor,
 	}
 }
 
 func (r *Resource) ReplicaSet() interfaces.ResourceReplicaSet {
 	return &ResourceReplicaSet{
-		Uri:           r.Uri,
-		RetryAttempts: r.RetryAttempts,
-		RetryDelay:    r.RetryDelay,
+		Executor: r.Executor,
+	}
+}
+
+func (r *Resource) Shard() interfaces.ResourceShard {
+	return &ResourceShard{
+		Executor: r.Executor,
+	}
+}
+
+func (r *Resource) ShardedCollection() interfaces.ResourceShardedCollection {
+	return &ResourceShardedCollection{
+		Executor: r.Executor,
+	}
+}
+
+func (r *Resource) ShardZone() interfaces.ResourceShardZone {
+	return &ResourceShardZone{
+		Executor: r.Executor,
+	}
+}
+
+func (r *Resource) ZoneKeyRange() interfaces.ResourceZoneKeyRange {
+	return &ResourceZoneKeyRange{
+		Executor: r.Executor,
+	}
+}
+
+func (r *Resource) Balancer() interfaces.ResourceBalancer {
+	return &ResourceBalancer{
+		Executor: r.Executor,
+	}
+}
+
+func (r *Resource) DefaultRWConcern() interfaces.ResourceDefaultRWConcern {
+	return &ResourceDefaultRWConcern{
+		Executor: r.Executor,
+	}
+}
+
+func (r *Resource) ServerParameter() interfaces.ResourceServerParameter {
+	return &ResourceServerParameter{
+		Executor: r.Executor,
+	}
+}
+
+func (r *Resource) ClusterParameter() interfaces.ResourceClusterParameter {
+	return &ResourceClusterParameter{
+		Executor: r.Executor,
+	}
+}
+
+func (r *Resource) FeatureCompatibilityVersion() interfaces.ResourceFeatureCompatibilityVersion {
+	return &ResourceFeatureCompatibilityVersion{
+		Executor: r.Executor,
+	}
+}
+
+func (r *Resource) Profiler() interfaces.ResourceProfiler {
+	return &ResourceProfiler{
+		Executor: r.Executor,
+	}
+}
+
+func (r *Resource) View() interfaces.ResourceView {
+	return &ResourceView{
+		Executor: r.Executor,
+	}
+}
+
+func (r *Resource) TimeSeriesCollection() interfaces.ResourceTimeSeriesCollection {
+	return &ResourceTimeSeriesCollection{
+		Executor: r.Executor,
+	}
+}
+
+func (r *Resource) ClusteredCollection() interfaces.ResourceClusteredCollection {
+	return &ResourceClusteredCollection{
+		Executor: r.Executor,
 	}
 }
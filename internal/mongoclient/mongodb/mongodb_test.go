@@ -0,0 +1,36 @@
+package mongodb
+
+import (
+	"testing"
+	"time"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// newTestExecutor returns an executor connected to the stub server which retries quickly.
+func newTestExecutor(srv *mongotest.Server) *executor.Executor {
+	return &executor.Executor{
+		Uri: srv.URI(),
+		Policy: executor.Policy{
+			Attempts: 3,
+			Delay:    time.Millisecond,
+			Backoff:  types.RetryBackoffFixed,
+		},
+	}
+}
+
+// lookup returns the value of the key of the command, failing the test when it is missing.
+func lookup(t *testing.T, cmd bson.Raw, key ...string) bson.RawValue {
+	t.Helper()
+
+	v, err := cmd.LookupErr(key...)
+	if err != nil {
+		t.Fatalf("command %s has no key %v", cmd, key)
+	}
+
+	return v
+}
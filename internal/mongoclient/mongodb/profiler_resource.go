@@ -0,0 +1,203 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// profilerUnsetFilter is the filter value which removes the filter of the profiler.
+const profilerUnsetFilter = "unset"
+
+// profilerSettings is the reply of the profile command with the settings before the command.
+type profilerSettings struct {
+	Was        int64    `bson:"was"`
+	SlowMS     int64    `bson:"slowms"`
+	SampleRate float64  `bson:"sampleRate"`
+	Filter     bson.Raw `bson:"filter"`
+}
+
+func (r *ResourceProfiler) Create(ctx context.Context, plan types.Profiler) error {
+	cmd, err := profileCommand(plan, false)
+	if err != nil {
+		return executor.Unrecoverable(err)
+	}
+
+	op := executor.Operation{Name: "create profiler"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		_, err := profile(ctx, c, plan.Database, cmd)
+
+		return err
+	})
+}
+
+// Update sets the plan, removing the filter when the plan has none.
+func (r *ResourceProfiler) Update(ctx context.Context, plan types.Profiler) error {
+	cmd, err := profileCommand(plan, true)
+	if err != nil {
+		return executor.Unrecoverable(err)
+	}
+
+	op := executor.Operation{Name: "update profiler"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		_, err := profile(ctx, c, plan.Database, cmd)
+
+		return err
+	})
+}
+
+// Delete turns the profiler off and removes its filter. Slowms and the sample rate are shared by all databases
+// and are kept.
+func (r *ResourceProfiler) Delete(ctx context.Context, state types.Profiler) error {
+	op := executor.Operation{Name: "delete profiler"}
+
+	return r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		_, err := profile(ctx, c, state.Database, bson.D{
+			{Key: "profile", Value: 0},
+			{Key: "filter", Value: profilerUnsetFilter},
+		})
+
+		return err
+	})
+}
+
+// Read returns the current settings. Slowms and the sample rate unset in the state are kept unset, as they may be
+// set by the profiler of another database.
+func (r *ResourceProfiler) Read(ctx context.Context, state types.Profiler) (types.Profiler, error) {
+	var current profilerSettings
+
+	op := executor.Operation{Name: "read profiler"}
+
+	err := r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		var err error
+
+		current, err = profile(ctx, c, state.Database, bson.D{{Key: "profile", Value: -1}})
+
+		return err
+	})
+	if err != nil {
+		return state, err
+	}
+
+	state.Level = current.Was
+
+	if state.SlowMS != nil {
+		state.SlowMS = &current.SlowMS
+	}
+
+	if state.SampleRate != nil {
+		state.SampleRate = &current.SampleRate
+	}
+
+	filter, err := formatProfilerFilter(current.Filter)
+	if err != nil {
+		return state, err
+	}
+
+	if filter == nil || state.Filter == nil || !sameExtJSON(*filter, *state.Filter) {
+		state.Filter = filter
+	}
+
+	return state, nil
+}
+
+// ImportState imports the profiler of the database. Slowms and the sample rate are imported
+// when they differ from the defaults of mongod.
+func (r *ResourceProfiler) ImportState(ctx context.Context, database string) (types.Profiler, error) {
+	var current profilerSettings
+
+	op := executor.Operation{Name: "import profiler"}
+
+	err := r.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		var err error
+
+		current, err = profile(ctx, c, database, bson.D{{Key: "profile", Value: -1}})
+
+		return err
+	})
+	if err != nil {
+		return types.Profiler{}, err
+	}
+
+	p := types.Profiler{Database: database, Level: current.Was}
+
+	if current.SlowMS != types.ProfilerDefaultSlowMS {
+		p.SlowMS = &current.SlowMS
+	}
+
+	if current.SampleRate != types.ProfilerDefaultSampleRate {
+		p.SampleRate = &current.SampleRate
+	}
+
+	p.Filter, err = formatProfilerFilter(current.Filter)
+	if err != nil {
+		return types.Profiler{}, err
+	}
+
+	return p, nil
+}
+
+// profile runs the profile command on the database and returns the settings before it.
+func profile(ctx context.Context, client *mongo.Client, database string, cmd bson.D) (profilerSettings, error) {
+	settings := profilerSettings{}
+
+	err := client.Database(database).RunCommand(ctx, cmd).Decode(&settings)
+	if err != nil {
+		return settings, fmt.Errorf("profile failed with error: %w", err)
+	}
+
+	return settings, nil
+}
+
+// profileCommand returns the profile command setting the profiler. Without a filter the command keeps
+// the current filter, or removes it if unsetFilter is set.
+func profileCommand(p types.Profiler, unsetFilter bool) (bson.D, error) {
+	cmd := bson.D{{Key: "profile", Value: p.Level}}
+
+	if p.SlowMS != nil {
+		cmd = append(cmd, bson.E{Key: "slowms", Value: *p.SlowMS})
+	}
+
+	if p.SampleRate != nil {
+		cmd = append(cmd, bson.E{Key: "sampleRate", Value: *p.SampleRate})
+	}
+
+	switch {
+	case p.Filter != nil:
+		var filter bson.Raw
+
+		err := bson.UnmarshalExtJSON([]byte(*p.Filter), false, &filter)
+		if err != nil {
+			return nil, fmt.Errorf("filter %s is not a JSON object: %w", *p.Filter, err)
+		}
+
+		cmd = append(cmd, bson.E{Key: "filter", Value: filter})
+	case unsetFilter:
+		cmd = append(cmd, bson.E{Key: "filter", Value: profilerUnsetFilter})
+	}
+
+	return cmd, nil
+}
+
+// formatProfilerFilter returns the filter in relaxed Extended JSON, or nil if there is none.
+func formatProfilerFilter(filter bson.Raw) (*string, error) {
+	if len(filter) == 0 {
+		return nil, nil
+	}
+
+	b, err := bson.MarshalExtJSON(filter, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	s := string(b)
+
+	return &s, nil
+}
@@ -0,0 +1,150 @@
+package mongodb
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// profilerStatus returns a profile handler reporting the settings.
+func profilerStatus(level int32, slowMS int32, sampleRate float64, filter bson.D) mongotest.Handler {
+	fields := []bson.E{
+		{Key: "was", Value: level},
+		{Key: "slowms", Value: slowMS},
+		{Key: "sampleRate", Value: sampleRate},
+	}
+
+	if filter != nil {
+		fields = append(fields, bson.E{Key: "filter", Value: filter})
+	}
+
+	return mongotest.Reply(fields...)
+}
+
+func TestResourceProfilerCreate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceProfiler{Executor: newTestExecutor(srv)}
+
+	slowMS := int64(200)
+	sampleRate := 0.5
+	filter := `{"op":"query","millis":{"$gt":2000}}`
+
+	err := r.Create(context.Background(), types.Profiler{Database: "app", Level: 1, SlowMS: &slowMS, SampleRate: &sampleRate, Filter: &filter})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmd := srv.Commands("profile")[0]
+
+	if db := lookup(t, cmd, "$db").StringValue(); db != "app" {
+		t.Fatalf("expected profile on app, got %s", db)
+	}
+
+	if v, ok := lookup(t, cmd, "profile").AsInt64OK(); !ok || v != 1 {
+		t.Fatalf("expected level 1, got %s", lookup(t, cmd, "profile"))
+	}
+
+	if v, ok := lookup(t, cmd, "slowms").AsInt64OK(); !ok || v != 200 {
+		t.Fatalf("expected slowms 200, got %s", lookup(t, cmd, "slowms"))
+	}
+
+	if v := lookup(t, cmd, "sampleRate").Double(); v != 0.5 {
+		t.Fatalf("expected sample rate 0.5, got %v", v)
+	}
+
+	if v, ok := lookup(t, cmd, "filter", "millis", "$gt").AsInt64OK(); !ok || v != 2000 {
+		t.Fatalf("expected filter on millis, got %s", lookup(t, cmd, "filter"))
+	}
+}
+
+func TestResourceProfilerUpdateRemovesFilter(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceProfiler{Executor: newTestExecutor(srv)}
+
+	if err := r.Update(context.Background(), types.Profiler{Database: "app", Level: 1}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmd := srv.Commands("profile")[0]
+
+	if v := lookup(t, cmd, "filter").StringValue(); v != profilerUnsetFilter {
+		t.Fatalf("expected filter %s, got %s", profilerUnsetFilter, v)
+	}
+
+	if _, err := cmd.LookupErr("slowms"); err == nil {
+		t.Fatal("expected slowms kept as is")
+	}
+}
+
+func TestResourceProfilerDelete(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceProfiler{Executor: newTestExecutor(srv)}
+
+	if err := r.Delete(context.Background(), types.Profiler{Database: "app", Level: 2}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmd := srv.Commands("profile")[0]
+
+	if v, ok := lookup(t, cmd, "profile").AsInt64OK(); !ok || v != 0 {
+		t.Fatalf("expected level 0, got %s", lookup(t, cmd, "profile"))
+	}
+
+	if v := lookup(t, cmd, "filter").StringValue(); v != profilerUnsetFilter {
+		t.Fatalf("expected filter %s, got %s", profilerUnsetFilter, v)
+	}
+}
+
+func TestResourceProfilerRead(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("profile", profilerStatus(2, 300, 1.0, bson.D{{Key: "millis", Value: bson.D{{Key: "$gt", Value: int64(2000)}}}}))
+
+	r := &ResourceProfiler{Executor: newTestExecutor(srv)}
+
+	sampleRate := 0.5
+	filter := `{"millis": {"$gt": 2000.0}}`
+
+	got, err := r.Read(context.Background(), types.Profiler{Database: "app", Level: 1, SampleRate: &sampleRate, Filter: &filter})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Level != 2 {
+		t.Fatalf("expected level 2, got %d", got.Level)
+	}
+
+	if got.SlowMS != nil {
+		t.Fatalf("expected slowms unset in the state to be kept unset, got %d", *got.SlowMS)
+	}
+
+	if got.SampleRate == nil || *got.SampleRate != 1.0 {
+		t.Fatalf("expected sample rate 1, got %v", got.SampleRate)
+	}
+
+	if got.Filter == nil || *got.Filter != filter {
+		t.Fatalf("expected the equal filter %s kept, got %v", filter, got.Filter)
+	}
+}
+
+func TestResourceProfilerImportState(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("profile", profilerStatus(1, 250, 1.0, nil))
+
+	r := &ResourceProfiler{Executor: newTestExecutor(srv)}
+
+	got, err := r.ImportState(context.Background(), "app")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Database != "app" || got.Level != 1 || got.SlowMS == nil || *got.SlowMS != 250 || got.SampleRate != nil || got.Filter != nil {
+		t.Fatalf("expected level 1 with slowms 250, got %+v", got)
+	}
+}
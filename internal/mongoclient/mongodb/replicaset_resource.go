@@ -5,191 +5,126 @@ import (
 	"fmt"
 	"time"
 
+	"terraform-provider-mongodb/internal/mongoclient/executor"
 	"terraform-provider-mongodb/internal/mongoclient/types"
 
-	"github.com/avast/retry-go/v4"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
-	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 func (r *ResourceReplicaSet) Create(ctx context.Context, plan types.ReplicaSet) error {
-	err := retry.Do(
-		func() error {
-			c, err := r.directConnect(ctx)
-			if err != nil {
-				return fmt.Errorf("connection to MongoDB failed with error: %s", err)
-			}
-
-			defer func() {
-				disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-				_ = c.Disconnect(disconnectCtx)
-				cancel()
-			}()
+	op := executor.Operation{Name: "create replica set", Mode: executor.ConnectDirect}
 
-			err = requiredVersion(ctx, c)
-			if err != nil {
-				return fmt.Errorf("required version check failed with error: %s", err)
-			}
-
-			err = c.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
-				{"replSetInitiate", plan},
-			}).Err()
-			if err != nil {
-				return fmt.Errorf("create replica set failed with error: %s", err)
-			}
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requiredVersion(ctx, c)
+		if err != nil {
+			return fmt.Errorf("required version check failed with error: %w", err)
+		}
 
-			return r.waitForReplicaSetReady(ctx, plan.Name)
-		},
-		retry.Attempts(r.RetryAttempts),
-		retry.DelayType(retry.BackOffDelay),
-		retry.Delay(r.RetryDelay),
-		retry.Context(ctx),
-	)
+		err = c.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+			{Key: "replSetInitiate", Value: plan},
+		}).Err()
+		if err != nil {
+			return fmt.Errorf("create replica set failed with error: %w", err)
+		}
 
-	return err
+		return r.waitForReplicaSetReady(ctx, plan.Name)
+	})
 }
 
 func (r *ResourceReplicaSet) Exists(ctx context.Context, state types.ReplicaSet) (bool, error) {
 	var rsc *types.ReplicaSetConfig
 
-	err := retry.Do(
-		func() error {
-			c, err := r.connect(ctx)
-			if err != nil {
-				return fmt.Errorf("connection to MongoDB failed with error: %s", err)
-			}
+	op := executor.Operation{Name: "check replica set existence", Mode: executor.ConnectReplicaSet}
 
-			defer func() {
-				disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-				_ = c.Disconnect(disconnectCtx)
-				cancel()
-			}()
-
-			err = requiredVersion(ctx, c)
-			if err != nil {
-				return fmt.Errorf("required version check failed with error: %s", err)
-			}
+	err := r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requiredVersion(ctx, c)
+		if err != nil {
+			return fmt.Errorf("required version check failed with error: %w", err)
+		}
 
-			rsc, err = getReplicaSetConfig(ctx, c)
-			if err != nil {
-				return fmt.Errorf("get replica set config failed with error: %s", err)
-			}
+		rsc, err = getReplicaSetConfig(ctx, c)
+		if err != nil {
+			return fmt.Errorf("get replica set config failed with error: %w", err)
+		}
 
-			return nil
-		},
-		retry.Attempts(r.RetryAttempts),
-		retry.DelayType(retry.BackOffDelay),
-		retry.Delay(r.RetryDelay),
-		retry.Context(ctx),
-	)
+		return nil
+	})
 
 	if err != nil {
-		return false, fmt.Errorf("failed to check if replica set exists: %s", err)
+		return false, fmt.Errorf("failed to check if replica set exists: %w", err)
 	}
 
 	return rsc.Config.Name == state.Name, nil
 }
 
 func (r *ResourceReplicaSet) Update(ctx context.Context, state types.ReplicaSet) error {
-	err := retry.Do(
-		func() error {
-			c, err := r.connect(ctx)
-			if err != nil {
-				return fmt.Errorf("connection to MongoDB failed with error: %s", err)
-			}
+	op := executor.Operation{Name: "update replica set", Mode: executor.ConnectReplicaSet}
 
-			defer func() {
-				disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-				_ = c.Disconnect(disconnectCtx)
-				cancel()
-			}()
-
-			err = requiredVersion(ctx, c)
-			if err != nil {
-				return fmt.Errorf("required version check failed with error: %s", err)
-			}
-
-			status, err := getReplicaSetStatus(ctx, c)
-			if err != nil {
-				return fmt.Errorf("get replica set status failed with error: %s", err)
-			}
+	return r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requiredVersion(ctx, c)
+		if err != nil {
+			return fmt.Errorf("required version check failed with error: %w", err)
+		}
 
-			if !isReplicaSetReady(status, state.Name) {
-				return fmt.Errorf("replica set %s not ready or corrupted", state.Name)
-			}
+		status, err := getReplicaSetStatus(ctx, c)
+		if err != nil {
+			return fmt.Errorf("get replica set status failed with error: %w", err)
+		}
 
-			// Get current config version and increment it
-			version, err := getReplicaSetConfigVersion(ctx, c)
-			if err != nil {
-				return fmt.Errorf("get replica set config version failed with error: %s", err)
-			}
+		if !isReplicaSetReady(status, state.Name) {
+			return executor.Transient(fmt.Errorf("replica set %s not ready or corrupted", state.Name))
+		}
 
-			version++
+		// Get current config version and increment it
+		version, err := getReplicaSetConfigVersion(ctx, c)
+		if err != nil {
+			return fmt.Errorf("get replica set config version failed with error: %w", err)
+		}
 
-			// Set the new version to the config
-			state.SetVersion(&version)
+		version++
 
-			err = c.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
-				{"replSetReconfig", state},
-			}).Err()
-			if err != nil {
-				return fmt.Errorf("updating replica set failed with error: %s", err)
-			}
+		// Set the new version to the config
+		state.SetVersion(&version)
 
-			// Clear version in state
-			state.ClearVersion()
+		err = c.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+			{Key: "replSetReconfig", Value: state},
+		}).Err()
+		if err != nil {
+			return fmt.Errorf("updating replica set failed with error: %w", err)
+		}
 
-			return r.waitForReplicaSetReady(ctx, state.Name)
-		},
-		retry.Attempts(r.RetryAttempts),
-		retry.DelayType(retry.BackOffDelay),
-		retry.Delay(r.RetryDelay),
-		retry.Context(ctx),
-	)
+		// Clear version in state
+		state.ClearVersion()
 
-	return err
+		return r.waitForReplicaSetReady(ctx, state.Name)
+	})
 }
 
 func (r *ResourceReplicaSet) ImportState(ctx context.Context, name string) (types.ReplicaSet, error) {
 	var rsc *types.ReplicaSetConfig
 
-	err := retry.Do(
-		func() error {
-			c, err := r.connect(ctx)
-			if err != nil {
-				return fmt.Errorf("connection to MongoDB failed with error: %s", err)
-			}
-
-			defer func() {
-				disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-				_ = c.Disconnect(disconnectCtx)
-				cancel()
-			}()
+	op := executor.Operation{Name: "import replica set", Mode: executor.ConnectReplicaSet}
 
-			err = requiredVersion(ctx, c)
-			if err != nil {
-				return fmt.Errorf("required version check failed with error: %s", err)
-			}
+	err := r.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requiredVersion(ctx, c)
+		if err != nil {
+			return fmt.Errorf("required version check failed with error: %w", err)
+		}
 
-			rsc, err = getReplicaSetConfig(ctx, c)
-			if err != nil {
-				return fmt.Errorf("get replica set config failed with error: %s", err)
-			}
+		rsc, err = getReplicaSetConfig(ctx, c)
+		if err != nil {
+			return fmt.Errorf("get replica set config failed with error: %w", err)
+		}
 
-			if rsc.Config.Name != name {
-				return retry.Unrecoverable(fmt.Errorf("replica set %s does not exist", name))
-			}
+		if rsc.Config.Name != name {
+			return executor.Unrecoverable(fmt.Errorf("replica set %s does not exist", name))
+		}
 
-			rsc.Config.RemoveDefaults()
+		rsc.Config.RemoveDefaults()
 
-			return nil
-		},
-		retry.Attempts(r.RetryAttempts),
-		retry.DelayType(retry.BackOffDelay),
-		retry.Delay(r.RetryDelay),
-		retry.Context(ctx),
-	)
+		return nil
+	})
 
 	if err != nil {
 		return types.ReplicaSet{}, err
@@ -198,53 +133,6 @@ func (r *ResourceReplicaSet) ImportState(ctx context.Context, name string) (type
 	return rsc.Config, nil
 }
 
-func (r *ResourceReplicaSet) connect(ctx context.Context) (*mongo.Client, error) {
-	opts := options.Client().ApplyURI(r.Uri)
-
-	if opts.ReplicaSet == nil {
-		return nil, fmt.Errorf("you can't use direct connection when working with replica set")
-	}
-
-	client, err := mongo.Connect(opts)
-	if err != nil {
-		return nil, err
-	}
-
-	err = client.Ping(ctx, nil)
-	if err != nil {
-		disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-		_ = client.Disconnect(disconnectCtx)
-		cancel()
-
-		return nil, fmt.Errorf("failed to ping MongoDB: %s", err)
-	}
-
-	return client, nil
-}
-
-func (r *ResourceReplicaSet) directConnect(ctx context.Context) (*mongo.Client, error) {
-	opts := options.Client().ApplyURI(r.Uri)
-	opts.ReplicaSet = nil
-	opts.Hosts = []string{opts.Hosts[0]}
-	opts.SetDirect(true)
-
-	client, err := mongo.Connect(opts)
-	if err != nil {
-		return nil, err
-	}
-
-	err = client.Ping(ctx, nil)
-	if err != nil {
-		disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-		_ = client.Disconnect(disconnectCtx)
-		cancel()
-
-		return nil, fmt.Errorf("failed to ping MongoDB: %s", err)
-	}
-
-	return client, nil
-}
-
 func (r *ResourceReplicaSet) waitForReplicaSetReady(ctx context.Context, replicaSetName string) error {
 	ticker := time.NewTicker(replicaSetPollingInterval)
 	defer ticker.Stop()
@@ -262,16 +150,14 @@ func (r *ResourceReplicaSet) waitForReplicaSetReady(ctx context.Context, replica
 			var status *types.ReplicaSetStatus
 			var err error
 
-			client, err = r.connect(ctx)
+			client, err = r.Executor.Connect(ctx, executor.ConnectReplicaSet)
 			if err != nil {
 				continue
 			}
 
 			status, err = getReplicaSetStatus(ctx, client)
 
-			disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-			_ = client.Disconnect(disconnectCtx)
-			cancel()
+			executor.Disconnect(ctx, client)
 
 			if err == nil && isReplicaSetReady(status, replicaSetName) {
 				return nil
@@ -0,0 +1,232 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func testReplicaSet() types.ReplicaSet {
+	return types.ReplicaSet{
+		Name: "rs0",
+		Members: []types.Member{
+			{Id: 0, Host: "mongo-0:27017"},
+			{Id: 1, Host: "mongo-1:27017"},
+		},
+	}
+}
+
+// replicaSetStatus returns a replSetGetStatus reply with a member in each state.
+func replicaSetStatus(name string, states ...string) mongotest.Handler {
+	members := bson.A{}
+	for i, state := range states {
+		members = append(members, bson.D{
+			{Key: "name", Value: testReplicaSet().Members[i].Host},
+			{Key: "stateStr", Value: state},
+			{Key: "health", Value: 1},
+		})
+	}
+
+	return mongotest.Reply(
+		bson.E{Key: "set", Value: name},
+		bson.E{Key: "members", Value: members},
+	)
+}
+
+func replicaSetConfig(version int64) mongotest.Handler {
+	return mongotest.Reply(bson.E{Key: "config", Value: bson.D{
+		{Key: "_id", Value: "rs0"},
+		{Key: "version", Value: version},
+		{Key: "members", Value: bson.A{
+			bson.D{{Key: "_id", Value: 0}, {Key: "host", Value: "mongo-0:27017"}},
+			bson.D{{Key: "_id", Value: 1}, {Key: "host", Value: "mongo-1:27017"}},
+		}},
+	}})
+}
+
+// setPollingInterval speeds up waitForReplicaSetReady for the test.
+func setPollingInterval(t *testing.T, d time.Duration) {
+	t.Helper()
+
+	prev := replicaSetPollingInterval
+	replicaSetPollingInterval = d
+
+	t.Cleanup(func() {
+		replicaSetPollingInterval = prev
+	})
+}
+
+func TestResourceReplicaSetCreate(t *testing.T) {
+	setPollingInterval(t, 10*time.Millisecond)
+
+	srv := mongotest.NewServer(t)
+	srv.SetReplicaSet("rs0")
+
+	// The replica set becomes ready after an election
+	srv.Script("replSetGetStatus",
+		mongotest.Fail(94),
+		replicaSetStatus("rs0", "STARTUP2", "STARTUP2"),
+		replicaSetStatus("rs0", "SECONDARY", "SECONDARY"),
+	)
+	srv.Handle("replSetGetStatus", replicaSetStatus("rs0", "PRIMARY", "SECONDARY"))
+
+	r := &ResourceReplicaSet{Executor: newTestExecutor(srv)}
+
+	if err := r.Create(context.Background(), testReplicaSet()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmds := srv.Commands("replSetInitiate")
+	if len(cmds) != 1 {
+		t.Fatalf("expected 1 replSetInitiate, got %d", len(cmds))
+	}
+
+	if name := lookup(t, cmds[0], "replSetInitiate", "_id").StringValue(); name != "rs0" {
+		t.Fatalf("expected replica set rs0, got %s", name)
+	}
+
+	if host := lookup(t, cmds[0], "replSetInitiate", "members", "1", "host").StringValue(); host != "mongo-1:27017" {
+		t.Fatalf("expected second member mongo-1:27017, got %s", host)
+	}
+
+	if calls := srv.Calls("replSetGetStatus"); calls != 4 {
+		t.Fatalf("expected to wait for 4 status checks, got %d", calls)
+	}
+}
+
+func TestResourceReplicaSetCreateNotReady(t *testing.T) {
+	setPollingInterval(t, 10*time.Millisecond)
+
+	srv := mongotest.NewServer(t)
+	srv.SetReplicaSet("rs0")
+	srv.Handle("replSetGetStatus", replicaSetStatus("rs0", "SECONDARY", "SECONDARY"))
+
+	r := &ResourceReplicaSet{Executor: newTestExecutor(srv)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	err := r.Create(ctx, testReplicaSet())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	if calls := srv.Calls("replSetInitiate"); calls != 1 {
+		t.Fatalf("expected no retries after timeout, got %d replSetInitiate calls", calls)
+	}
+}
+
+func TestResourceReplicaSetUpdate(t *testing.T) {
+	setPollingInterval(t, 10*time.Millisecond)
+
+	srv := mongotest.NewServer(t)
+	srv.SetReplicaSet("rs0")
+	srv.Handle("replSetGetStatus", replicaSetStatus("rs0", "PRIMARY", "SECONDARY"))
+	srv.Handle("replSetGetConfig", replicaSetConfig(4))
+
+	r := &ResourceReplicaSet{Executor: newTestExecutor(srv)}
+
+	if err := r.Update(context.Background(), testReplicaSet()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmds := srv.Commands("replSetReconfig")
+	if len(cmds) != 1 {
+		t.Fatalf("expected 1 replSetReconfig, got %d", len(cmds))
+	}
+
+	if version := lookup(t, cmds[0], "replSetReconfig", "version").AsInt64(); version != 5 {
+		t.Fatalf("expected version 5, got %d", version)
+	}
+}
+
+func TestResourceReplicaSetUpdateNotReady(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.SetReplicaSet("rs0")
+	srv.Handle("replSetGetStatus", replicaSetStatus("rs0", "SECONDARY", "RECOVERING"))
+
+	r := &ResourceReplicaSet{Executor: newTestExecutor(srv)}
+
+	err := r.Update(context.Background(), testReplicaSet())
+	if err == nil || !strings.Contains(err.Error(), "replica set rs0 not ready or corrupted") {
+		t.Fatalf("expected not ready error, got %v", err)
+	}
+
+	if calls := srv.Calls("replSetReconfig"); calls != 0 {
+		t.Fatalf("expected no replSetReconfig, got %d", calls)
+	}
+}
+
+func TestResourceReplicaSetExists(t *testing.T) {
+	tests := []struct {
+		name       string
+		replicaSet string
+		config     mongotest.Handler
+		want       bool
+		wantErr    string
+		wantCalls  int
+	}{
+		{
+			name:       "replica set exists",
+			replicaSet: "rs0",
+			config:     replicaSetConfig(1),
+			want:       true,
+			wantCalls:  1,
+		},
+		{
+			name:       "replica set not initialized",
+			replicaSet: "rs0",
+			config:     mongotest.Fail(94),
+			wantErr:    "replica set not initialized",
+			wantCalls:  1,
+		},
+		{
+			name:       "replication not enabled",
+			replicaSet: "rs0",
+			config:     mongotest.Fail(76),
+			wantErr:    "replication not enabled",
+			wantCalls:  1,
+		},
+		{
+			name:      "connection string without replica set",
+			config:    replicaSetConfig(1),
+			wantErr:   "you can't use direct connection when working with replica set",
+			wantCalls: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.SetReplicaSet(tt.replicaSet)
+			srv.Handle("replSetGetConfig", tt.config)
+
+			r := &ResourceReplicaSet{Executor: newTestExecutor(srv)}
+
+			got, err := r.Exists(context.Background(), testReplicaSet())
+
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+
+			if got != tt.want {
+				t.Fatalf("expected exists %t, got %t", tt.want, got)
+			}
+
+			if calls := srv.Calls("replSetGetConfig"); calls != tt.wantCalls {
+				t.Fatalf("expected %d replSetGetConfig calls, got %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Create sets the parameter on its hosts and returns the parameter with the previous value of each host.
+// The previous value is read before setting it, so a retried setParameter does not overwrite it. When a host
+// fails, the returned parameter holds the previous values of the hosts already set.
+func (r *ResourceServerParameter) Create(ctx context.Context, plan types.ServerParameter) (types.ServerParameter, error) {
+	value, err := parseExtJSON(plan.Value)
+	if err != nil {
+		return plan, executor.Unrecoverable(err)
+	}
+
+	hosts, err := r.parameterHosts(ctx, plan)
+	if err != nil {
+		return plan, err
+	}
+
+	plan.PreviousValues = map[string]string{}
+
+	for _, host := range hosts {
+		op := executor.Operation{Name: "create server parameter", Mode: executor.ConnectDirect, Host: host}
+
+		previous, err := r.getHostParameter(ctx, plan.Retry, op, plan.Name)
+		if err != nil {
+			return plan, err
+		}
+
+		err = r.setHostParameter(ctx, plan.Retry, op, plan.Name, value)
+		if err != nil {
+			return plan, err
+		}
+
+		plan.PreviousValues[host] = previous
+	}
+
+	return plan, nil
+}
+
+// Update sets the new value on the hosts of the state, keeping their previous values.
+func (r *ResourceServerParameter) Update(ctx context.Context, plan types.ServerParameter) error {
+	value, err := parseExtJSON(plan.Value)
+	if err != nil {
+		return executor.Unrecoverable(err)
+	}
+
+	for _, host := range slices.Sorted(maps.Keys(plan.PreviousValues)) {
+		op := executor.Operation{Name: "update server parameter", Mode: executor.ConnectDirect, Host: host}
+
+		err := r.setHostParameter(ctx, plan.Retry, op, plan.Name, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Delete restores the previous value of the parameter on every host.
+func (r *ResourceServerParameter) Delete(ctx context.Context, state types.ServerParameter) error {
+	for _, host := range slices.Sorted(maps.Keys(state.PreviousValues)) {
+		previous, err := parseExtJSON(state.PreviousValues[host])
+		if err != nil {
+			return executor.Unrecoverable(err)
+		}
+
+		op := executor.Operation{Name: "delete server parameter", Mode: executor.ConnectDirect, Host: host}
+
+		err = r.setHostParameter(ctx, state.Retry, op, state.Name, previous)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Read returns the parameter with the first value which differs from the state, so drift on any host is detected.
+// Values equal to the state, e.g. 1 and 1.0, keep the state value.
+func (r *ResourceServerParameter) Read(ctx context.Context, state types.ServerParameter) (types.ServerParameter, error) {
+	for _, host := range slices.Sorted(maps.Keys(state.PreviousValues)) {
+		op := executor.Operation{Name: "read server parameter", Mode: executor.ConnectDirect, Host: host}
+
+		current, err := r.getHostParameter(ctx, state.Retry, op, state.Name)
+		if err != nil {
+			return state, err
+		}
+
+		if !sameExtJSON(current, state.Value) {
+			state.Value = current
+			break
+		}
+	}
+
+	return state, nil
+}
+
+// ImportState imports the parameter by the <name>, <host>/<name> or */<name> ID. The current values are taken
+// as the previous values, so deleting the imported resource keeps them.
+func (r *ResourceServerParameter) ImportState(ctx context.Context, id string) (types.ServerParameter, error) {
+	p, ok := types.ParseServerParameterID(id)
+	if !ok {
+		return types.ServerParameter{}, fmt.Errorf("invalid import ID %q, expected <name>, <host>/<name> or %s/<name>", id, types.AllMembersHost)
+	}
+
+	hosts, err := r.parameterHosts(ctx, p)
+	if err != nil {
+		return types.ServerParameter{}, err
+	}
+
+	p.PreviousValues = map[string]string{}
+
+	for _, host := range hosts {
+		op := executor.Operation{Name: "import server parameter", Mode: executor.ConnectDirect, Host: host}
+
+		current, err := r.getHostParameter(ctx, nil, op, p.Name)
+		if err != nil {
+			return types.ServerParameter{}, err
+		}
+
+		p.PreviousValues[host] = current
+
+		if p.Value == "" {
+			p.Value = current
+		}
+	}
+
+	return p, nil
+}
+
+// getHostParameter returns the value of the parameter on the host of the operation in relaxed Extended JSON.
+func (r *ResourceServerParameter) getHostParameter(ctx context.Context, retry *types.Retry, op executor.Operation, name string) (string, error) {
+	var value string
+
+	err := r.Executor.WithRetry(retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		current, err := getParameter(ctx, c, name)
+		if err != nil {
+			return err
+		}
+
+		value, err = formatExtJSON(current)
+
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s on %s: %w", name, op.Host, err)
+	}
+
+	return value, nil
+}
+
+// setHostParameter sets the parameter on the host of the operation.
+func (r *ResourceServerParameter) setHostParameter(ctx context.Context, retry *types.Retry, op executor.Operation, name string, value bson.RawValue) error {
+	err := r.Executor.WithRetry(retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		return setParameter(ctx, c, name, value)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set %s on %s: %w", name, op.Host, err)
+	}
+
+	return nil
+}
+
+// parameterHosts returns the hosts the parameter is set on: the host of the parameter, every member
+// of the replica set, or the first host of the connection string.
+func (r *ResourceServerParameter) parameterHosts(ctx context.Context, p types.ServerParameter) ([]string, error) {
+	if p.Host != nil {
+		return []string{*p.Host}, nil
+	}
+
+	if !p.IsAllMembers() {
+		hosts, err := r.Executor.Hosts()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(hosts) == 0 {
+			return nil, executor.Unrecoverable(fmt.Errorf("connection string has no hosts"))
+		}
+
+		return hosts[:1], nil
+	}
+
+	var hosts []string
+
+	op := executor.Operation{Name: "list replica set members"}
+
+	err := r.Executor.WithRetry(p.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		rsc, err := getReplicaSetConfig(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		hosts = hosts[:0]
+		for _, m := range rsc.Config.Members {
+			hosts = append(hosts, m.Host)
+		}
+
+		return nil
+	})
+
+	return hosts, err
+}
+
+// setParameter sets the runtime parameter on the server of the client.
+func setParameter(ctx context.Context, client *mongo.Client, name string, value bson.RawValue) error {
+	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+		{Key: "setParameter", Value: 1},
+		{Key: name, Value: value},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("setParameter failed with error: %w", err)
+	}
+
+	return nil
+}
+
+// getParameter returns the current value of the parameter.
+func getParameter(ctx context.Context, client *mongo.Client, name string) (bson.RawValue, error) {
+	reply, err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+		{Key: "getParameter", Value: 1},
+		{Key: name, Value: 1},
+	}).Raw()
+	if err != nil {
+		return bson.RawValue{}, fmt.Errorf("getParameter failed with error: %w", err)
+	}
+
+	value, err := reply.LookupErr(name)
+	if err != nil {
+		return bson.RawValue{}, executor.Unrecoverable(fmt.Errorf("parameter %s not found", name))
+	}
+
+	return value, nil
+}
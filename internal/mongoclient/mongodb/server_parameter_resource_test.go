@@ -0,0 +1,184 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// logLevel returns a getParameter handler reporting the logLevel parameter.
+func logLevel(v interface{}) mongotest.Handler {
+	return mongotest.Reply(bson.E{Key: "logLevel", Value: v})
+}
+
+func TestResourceServerParameterCreate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("getParameter", logLevel(int32(0)))
+
+	r := &ResourceServerParameter{Executor: newTestExecutor(srv)}
+
+	got, err := r.Create(context.Background(), types.ServerParameter{Name: "logLevel", Value: "2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.PreviousValues[srv.Addr] != "0" {
+		t.Fatalf("expected previous value 0 of %s, got %v", srv.Addr, got.PreviousValues)
+	}
+
+	if v := lookup(t, srv.Commands("setParameter")[0], "logLevel"); v.Type != bson.TypeInt32 || v.Int32() != 2 {
+		t.Fatalf("expected int32 logLevel 2, got %s", v)
+	}
+}
+
+func TestResourceServerParameterCreateAllMembers(t *testing.T) {
+	primary := mongotest.NewServer(t)
+	secondary := mongotest.NewServer(t)
+
+	primary.Handle("replSetGetConfig", mongotest.Reply(bson.E{Key: "config", Value: bson.D{
+		{Key: "_id", Value: "rs0"},
+		{Key: "version", Value: 1},
+		{Key: "members", Value: bson.A{
+			bson.D{{Key: "_id", Value: 0}, {Key: "host", Value: primary.Addr}},
+			bson.D{{Key: "_id", Value: 1}, {Key: "host", Value: secondary.Addr}},
+		}},
+	}}))
+	primary.Handle("getParameter", logLevel(int32(0)))
+	secondary.Handle("getParameter", logLevel(int32(1)))
+
+	r := &ResourceServerParameter{Executor: newTestExecutor(primary)}
+
+	allMembers := true
+	got, err := r.Create(context.Background(), types.ServerParameter{Name: "logLevel", Value: "2", AllMembers: &allMembers})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.PreviousValues[primary.Addr] != "0" || got.PreviousValues[secondary.Addr] != "1" {
+		t.Fatalf("expected previous values of both members, got %v", got.PreviousValues)
+	}
+
+	if primary.Calls("setParameter") != 1 || secondary.Calls("setParameter") != 1 {
+		t.Fatal("expected the parameter set on both members")
+	}
+}
+
+func TestResourceServerParameterCreatePartial(t *testing.T) {
+	primary := mongotest.NewServer(t)
+	secondary := mongotest.NewServer(t)
+
+	primary.Handle("replSetGetConfig", mongotest.Reply(bson.E{Key: "config", Value: bson.D{
+		{Key: "_id", Value: "rs0"},
+		{Key: "version", Value: 1},
+		{Key: "members", Value: bson.A{
+			bson.D{{Key: "_id", Value: 0}, {Key: "host", Value: primary.Addr}},
+			bson.D{{Key: "_id", Value: 1}, {Key: "host", Value: secondary.Addr}},
+		}},
+	}}))
+	primary.Handle("getParameter", logLevel(int32(0)))
+	secondary.Handle("getParameter", logLevel(int32(1)))
+	secondary.Handle("setParameter", mongotest.Fail(2))
+
+	r := &ResourceServerParameter{Executor: newTestExecutor(primary)}
+
+	allMembers := true
+	got, err := r.Create(context.Background(), types.ServerParameter{Name: "logLevel", Value: "2", AllMembers: &allMembers})
+	if err == nil || !strings.Contains(err.Error(), "failed to set logLevel on "+secondary.Addr) {
+		t.Fatalf("expected set error on the secondary, got %v", err)
+	}
+
+	if len(got.PreviousValues) != 1 || got.PreviousValues[primary.Addr] != "0" {
+		t.Fatalf("expected only the previous value of the primary, got %v", got.PreviousValues)
+	}
+}
+
+func TestResourceServerParameterCreateUnknown(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceServerParameter{Executor: newTestExecutor(srv)}
+
+	_, err := r.Create(context.Background(), types.ServerParameter{Name: "noSuchParameter", Value: "1"})
+	if err == nil || !strings.Contains(err.Error(), "failed to get noSuchParameter") {
+		t.Fatalf("expected get error, got %v", err)
+	}
+
+	if srv.Calls("setParameter") != 0 {
+		t.Fatal("expected no setParameter")
+	}
+}
+
+func TestResourceServerParameterDeleteRestoresPrevious(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceServerParameter{Executor: newTestExecutor(srv)}
+
+	state := types.ServerParameter{
+		Name:           "transactionLifetimeLimitSeconds",
+		Value:          "120",
+		PreviousValues: map[string]string{srv.Addr: `{"$numberLong":"60"}`},
+	}
+
+	if err := r.Delete(context.Background(), state); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	v := lookup(t, srv.Commands("setParameter")[0], "transactionLifetimeLimitSeconds")
+	if v.Type != bson.TypeInt64 || v.Int64() != 60 {
+		t.Fatalf("expected int64 60 restored, got %s", v)
+	}
+}
+
+func TestResourceServerParameterRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		current interface{}
+		state   string
+		want    string
+	}{
+		{name: "same value", current: int32(2), state: "2", want: "2"},
+		{name: "same number of another type", current: float64(2), state: "2", want: "2"},
+		{name: "drift", current: int32(5), state: "2", want: "5"},
+		{name: "string value", current: "debug", state: `"debug"`, want: `"debug"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.Handle("getParameter", logLevel(tt.current))
+
+			r := &ResourceServerParameter{Executor: newTestExecutor(srv)}
+
+			state := types.ServerParameter{Name: "logLevel", Value: tt.state, PreviousValues: map[string]string{srv.Addr: "0"}}
+
+			got, err := r.Read(context.Background(), state)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.Value != tt.want {
+				t.Fatalf("expected value %s, got %s", tt.want, got.Value)
+			}
+		})
+	}
+}
+
+func TestResourceServerParameterImportState(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("getParameter", logLevel(int32(1)))
+
+	r := &ResourceServerParameter{Executor: newTestExecutor(srv)}
+
+	got, err := r.ImportState(context.Background(), srv.Addr+"/logLevel")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Name != "logLevel" || got.Host == nil || *got.Host != srv.Addr || got.Value != "1" || got.PreviousValues[srv.Addr] != "1" {
+		t.Fatalf("expected logLevel 1 of %s, got %+v", srv.Addr, got)
+	}
+}
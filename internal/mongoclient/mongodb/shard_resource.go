@@ -0,0 +1,188 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func (r *ResourceShard) Create(ctx context.Context, plan types.Shard) error {
+	op := executor.Operation{Name: "create shard"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		shards, err := listShards(ctx, c)
+		if err != nil {
+			return fmt.Errorf("failed to check if shard exists: %w", err)
+		}
+
+		if shards.Exist(plan.Name) {
+			return executor.Unrecoverable(fmt.Errorf("shard %s already exists", plan.Name))
+		}
+
+		return c.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+			{Key: "addShard", Value: plan.Host},
+			{Key: "name", Value: plan.Name},
+		}).Err()
+	})
+}
+
+// Read returns the shard with the host reported by listShards, unless it names the same replica set
+// as the state, and whether the shard exists.
+func (r *ResourceShard) Read(ctx context.Context, state types.Shard) (types.Shard, bool, error) {
+	var exist bool
+
+	op := executor.Operation{Name: "read shard"}
+
+	err := r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		shards, err := listShards(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		shard := shards.Get(state.Name)
+		exist = shard != nil
+
+		if exist && !types.SameShardHost(state.Host, shard.Host) {
+			state.Host = shard.Host
+		}
+
+		return nil
+	})
+
+	return state, exist, err
+}
+
+// Delete drains the shard and removes it from the cluster. Draining moves every chunk to the other
+// shards, so it is polled until it completes or the context is done.
+func (r *ResourceShard) Delete(ctx context.Context, state types.Shard) error {
+	op := executor.Operation{Name: "delete shard"}
+
+	return r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		shards, err := listShards(ctx, c)
+		if err != nil {
+			return fmt.Errorf("failed to check if shard exists: %w", err)
+		}
+
+		// A shard removed by a previous attempt or outside Terraform is already deleted
+		if !shards.Exist(state.Name) {
+			return nil
+		}
+
+		return waitForShardRemoval(ctx, c, state.Name)
+	})
+}
+
+func (r *ResourceShard) ImportState(ctx context.Context, name string) (types.Shard, error) {
+	var s types.Shard
+
+	op := executor.Operation{Name: "import shard"}
+
+	err := r.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		shards, err := listShards(ctx, c)
+		if err != nil {
+			return fmt.Errorf("failed to check if shard exists: %w", err)
+		}
+
+		shard := shards.Get(name)
+		if shard == nil {
+			return executor.Unrecoverable(fmt.Errorf("shard %s does not exist", name))
+		}
+
+		s = types.Shard{
+			Name: shard.Name,
+			Host: shard.Host,
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return types.Shard{}, err
+	}
+
+	return s, nil
+}
+
+// waitForShardRemoval runs removeShard until the shard is removed. The first call starts draining,
+// the following calls report the progress. A shard which is no longer found is removed. A timed out removal reports what was left to drain, as the
+// chunks of a large shard can take longer than any timeout to move.
+func waitForShardRemoval(ctx context.Context, client *mongo.Client, name string) error {
+	ticker := time.NewTicker(shardRemovalPollingInterval)
+	defer ticker.Stop()
+
+	var last *types.ShardRemoval
+
+	for {
+		removal := types.ShardRemoval{}
+
+		err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+			{Key: "removeShard", Value: name},
+		}).Decode(&removal)
+		var commandErr mongo.CommandError
+		if errors.As(err, &commandErr) && commandErr.Code == 70 { // ShardNotFound
+			return nil
+		}
+		if last != nil && errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("shard %s removal did not complete, %s: %w", name, describeShardRemoval(*last), context.DeadlineExceeded)
+		}
+		if err != nil {
+			return fmt.Errorf("remove shard failed with error: %w", err)
+		}
+
+		if removal.State == types.ShardRemovalCompleted {
+			return nil
+		}
+
+		last = &removal
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("shard %s removal did not complete, %s: %w", name, describeShardRemoval(removal), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// describeShardRemoval returns what is left to drain, including the databases which must be moved manually.
+func describeShardRemoval(removal types.ShardRemoval) string {
+	d := fmt.Sprintf(
+		"%d chunks, %d databases and %d jumbo chunks remaining",
+		removal.Remaining.Chunks,
+		removal.Remaining.Dbs,
+		removal.Remaining.JumboChunks,
+	)
+
+	if len(removal.DbsToMove) > 0 {
+		d += fmt.Sprintf(". Move the primary of databases %s to another shard with movePrimary", strings.Join(removal.DbsToMove, ", "))
+	}
+
+	return d
+}
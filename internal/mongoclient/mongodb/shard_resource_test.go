@@ -0,0 +1,215 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func testShard() types.Shard {
+	return types.Shard{Name: "shard01", Host: "shard01/mongo-0:27018,mongo-1:27018"}
+}
+
+// listShardsReply returns a listShards reply with the shards.
+func listShardsReply(shards ...types.Shard) mongotest.Handler {
+	docs := bson.A{}
+	for _, shard := range shards {
+		docs = append(docs, bson.D{{Key: "_id", Value: shard.Name}, {Key: "host", Value: shard.Host}, {Key: "state", Value: 1}})
+	}
+
+	return mongotest.Reply(bson.E{Key: "shards", Value: docs})
+}
+
+// removeShardReply returns a removeShard reply in the state with the chunks remaining.
+func removeShardReply(state string, chunks int64, dbsToMove ...string) mongotest.Handler {
+	return mongotest.Reply(
+		bson.E{Key: "state", Value: state},
+		bson.E{Key: "remaining", Value: bson.D{{Key: "chunks", Value: chunks}, {Key: "dbs", Value: int64(len(dbsToMove))}, {Key: "jumboChunks", Value: 0}}},
+		bson.E{Key: "dbsToMove", Value: append([]string{}, dbsToMove...)},
+	)
+}
+
+func setShardRemovalPollingInterval(t *testing.T, d time.Duration) {
+	t.Helper()
+
+	prev := shardRemovalPollingInterval
+	shardRemovalPollingInterval = d
+
+	t.Cleanup(func() {
+		shardRemovalPollingInterval = prev
+	})
+}
+
+func TestResourceShardCreate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+
+	r := &ResourceShard{Executor: newTestExecutor(srv)}
+
+	if err := r.Create(context.Background(), testShard()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmds := srv.Commands("addShard")
+	if len(cmds) != 1 {
+		t.Fatalf("expected 1 addShard, got %d", len(cmds))
+	}
+
+	if host := lookup(t, cmds[0], "addShard").StringValue(); host != testShard().Host {
+		t.Fatalf("expected host %s, got %s", testShard().Host, host)
+	}
+
+	if name := lookup(t, cmds[0], "name").StringValue(); name != testShard().Name {
+		t.Fatalf("expected name %s, got %s", testShard().Name, name)
+	}
+}
+
+func TestResourceShardCreateNotMongos(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceShard{Executor: newTestExecutor(srv)}
+
+	err := r.Create(context.Background(), testShard())
+	if err == nil || !strings.Contains(err.Error(), "sharding is managed through mongos") {
+		t.Fatalf("expected mongos error, got %v", err)
+	}
+
+	if calls := srv.Calls("addShard"); calls != 0 {
+		t.Fatalf("expected no addShard, got %d", calls)
+	}
+}
+
+func TestResourceShardDelete(t *testing.T) {
+	setShardRemovalPollingInterval(t, 10*time.Millisecond)
+
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+	srv.Handle("listShards", listShardsReply(testShard()))
+	srv.Script("removeShard",
+		removeShardReply(types.ShardRemovalStarted, 0),
+		removeShardReply(types.ShardRemovalOngoing, 10),
+		removeShardReply(types.ShardRemovalOngoing, 2),
+	)
+	srv.Handle("removeShard", removeShardReply(types.ShardRemovalCompleted, 0))
+
+	r := &ResourceShard{Executor: newTestExecutor(srv)}
+
+	if err := r.Delete(context.Background(), testShard()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if calls := srv.Calls("removeShard"); calls != 4 {
+		t.Fatalf("expected removeShard to be polled 4 times, got %d", calls)
+	}
+}
+
+func TestResourceShardDeleteMissing(t *testing.T) {
+	tests := []struct {
+		name   string
+		shards []types.Shard
+	}{
+		{name: "not listed"},
+		{name: "removed before removeShard", shards: []types.Shard{testShard()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.SetMongos(true)
+			srv.Handle("listShards", listShardsReply(tt.shards...))
+
+			r := &ResourceShard{Executor: newTestExecutor(srv)}
+
+			if err := r.Delete(context.Background(), testShard()); err != nil {
+				t.Fatalf("expected a missing shard to be deleted, got %s", err)
+			}
+
+			if calls := srv.Calls("removeShard"); calls != len(tt.shards) {
+				t.Fatalf("expected %d removeShard calls, got %d", len(tt.shards), calls)
+			}
+		})
+	}
+}
+
+func TestResourceShardDeleteTimeout(t *testing.T) {
+	setShardRemovalPollingInterval(t, 10*time.Millisecond)
+
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+	srv.Handle("listShards", listShardsReply(testShard()))
+	srv.Handle("removeShard", removeShardReply(types.ShardRemovalOngoing, 0, "app"))
+
+	r := &ResourceShard{Executor: newTestExecutor(srv)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	err := r.Delete(ctx, testShard())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	if !strings.Contains(err.Error(), "Move the primary of databases app") {
+		t.Fatalf("expected databases to move in error, got %v", err)
+	}
+}
+
+func TestResourceShardRead(t *testing.T) {
+	tests := []struct {
+		name      string
+		stateHost string
+		wantHost  string
+		wantExist bool
+	}{
+		{name: "same host", stateHost: testShard().Host, wantHost: testShard().Host, wantExist: true},
+		{name: "seed host of the replica set", stateHost: "shard01/mongo-0:27018", wantHost: "shard01/mongo-0:27018", wantExist: true},
+		{name: "other replica set", stateHost: "shard99/mongo-9:27018", wantHost: testShard().Host, wantExist: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.SetMongos(true)
+			srv.Handle("listShards", listShardsReply(testShard()))
+
+			r := &ResourceShard{Executor: newTestExecutor(srv)}
+
+			got, exist, err := r.Read(context.Background(), types.Shard{Name: testShard().Name, Host: tt.stateHost})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if exist != tt.wantExist || got.Host != tt.wantHost {
+				t.Fatalf("expected exist %t and host %s, got %t and %s", tt.wantExist, tt.wantHost, exist, got.Host)
+			}
+		})
+	}
+}
+
+func TestResourceShardImportState(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+	srv.Handle("listShards", listShardsReply(testShard()))
+
+	r := &ResourceShard{Executor: newTestExecutor(srv)}
+
+	got, err := r.ImportState(context.Background(), testShard().Name)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Host != testShard().Host {
+		t.Fatalf("expected host %s, got %s", testShard().Host, got.Host)
+	}
+
+	if _, err := r.ImportState(context.Background(), "shard02"); err == nil {
+		t.Fatal("expected error for unknown shard")
+	}
+}
@@ -0,0 +1,128 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func (r *ResourceShardZone) Create(ctx context.Context, plan types.ShardZone) error {
+	op := executor.Operation{Name: "create shard zone"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		zones, exist, err := getShardZones(ctx, c, plan.Shard)
+		if err != nil {
+			return fmt.Errorf("failed to get shard zones: %w", err)
+		}
+
+		if !exist {
+			return executor.Unrecoverable(fmt.Errorf("shard %s does not exist", plan.Shard))
+		}
+
+		if slices.Contains(zones, plan.Zone) {
+			return executor.Unrecoverable(fmt.Errorf("shard %s is already in zone %s", plan.Shard, plan.Zone))
+		}
+
+		return c.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+			{Key: "addShardToZone", Value: plan.Shard},
+			{Key: "zone", Value: plan.Zone},
+		}).Err()
+	})
+}
+
+// Delete removes the shard from the zone. MongoDB refuses it while the zone has key ranges
+// and the shard is the last one in the zone.
+func (r *ResourceShardZone) Delete(ctx context.Context, state types.ShardZone) error {
+	op := executor.Operation{Name: "delete shard zone"}
+
+	return r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		zones, _, err := getShardZones(ctx, c, state.Shard)
+		if err != nil {
+			return fmt.Errorf("failed to get shard zones: %w", err)
+		}
+
+		if !slices.Contains(zones, state.Zone) {
+			return executor.Unrecoverable(fmt.Errorf("shard %s is not in zone %s", state.Shard, state.Zone))
+		}
+
+		return c.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+			{Key: "removeShardFromZone", Value: state.Shard},
+			{Key: "zone", Value: state.Zone},
+		}).Err()
+	})
+}
+
+func (r *ResourceShardZone) Exists(ctx context.Context, state types.ShardZone) (bool, error) {
+	var exist bool
+
+	op := executor.Operation{Name: "check shard zone existence"}
+
+	err := r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		zones, _, err := getShardZones(ctx, c, state.Shard)
+		if err != nil {
+			return err
+		}
+
+		exist = slices.Contains(zones, state.Zone)
+
+		return nil
+	})
+
+	return exist, err
+}
+
+// ImportState imports the zone of a shard by the <shard>/<zone> ID.
+func (r *ResourceShardZone) ImportState(ctx context.Context, id string) (types.ShardZone, error) {
+	op := executor.Operation{Name: "import shard zone"}
+
+	shard, zone, ok := strings.Cut(id, "/")
+	if !ok || shard == "" || zone == "" {
+		return types.ShardZone{}, fmt.Errorf("invalid import ID %q, expected <shard>/<zone>", id)
+	}
+
+	err := r.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		zones, _, err := getShardZones(ctx, c, shard)
+		if err != nil {
+			return fmt.Errorf("failed to get shard zones: %w", err)
+		}
+
+		if !slices.Contains(zones, zone) {
+			return executor.Unrecoverable(fmt.Errorf("shard %s is not in zone %s", shard, zone))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return types.ShardZone{}, err
+	}
+
+	return types.ShardZone{Shard: shard, Zone: zone}, nil
+}
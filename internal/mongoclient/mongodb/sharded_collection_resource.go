@@ -0,0 +1,201 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Create enables sharding on the database and shards the collection. enableSharding is a no-op
+// when sharding is already enabled, so it is run every time. A collection already sharded with the
+// planned key and uniqueness is taken as created.
+func (r *ResourceShardedCollection) Create(ctx context.Context, plan types.ShardedCollection) error {
+	op := executor.Operation{Name: "create sharded collection"}
+	ns := plan.Namespace()
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		current, err := getShardedCollection(ctx, c, ns)
+		if err != nil {
+			return fmt.Errorf("failed to check if collection is sharded: %w", err)
+		}
+
+		// A retried attempt finds the collection sharded by the previous attempt whose reply was lost
+		if current != nil {
+			unique := plan.Unique != nil && *plan.Unique
+			if shardKeyFromDocument(current.Key).Equal(plan.Key) && current.Unique == unique {
+				return nil
+			}
+
+			return executor.Unrecoverable(fmt.Errorf("collection %s is already sharded with another key", ns))
+		}
+
+		err = c.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+			{Key: "enableSharding", Value: plan.Database},
+		}).Err()
+		if err != nil {
+			return fmt.Errorf("failed to enable sharding on database %s: %w", plan.Database, err)
+		}
+
+		cmd := bson.D{
+			{Key: "shardCollection", Value: ns},
+			{Key: "key", Value: shardKeyDocument(plan.Key)},
+		}
+
+		if plan.Unique != nil {
+			cmd = append(cmd, bson.E{Key: "unique", Value: *plan.Unique})
+		}
+
+		if plan.NumInitialChunks != nil {
+			cmd = append(cmd, bson.E{Key: "numInitialChunks", Value: *plan.NumInitialChunks})
+		}
+
+		if plan.PresplitHashedZones != nil {
+			cmd = append(cmd, bson.E{Key: "presplitHashedZones", Value: *plan.PresplitHashedZones})
+		}
+
+		if plan.Collation != nil {
+			cmd = append(cmd, bson.E{Key: "collation", Value: bson.D{{Key: "locale", Value: *plan.Collation}}})
+		}
+
+		return c.Database(types.DefaultDatabase).RunCommand(ctx, cmd).Err()
+	})
+}
+
+// Update changes the shard key. A key extended with new suffix fields is refined in place,
+// any other key change reshards the collection, which rewrites all of its data.
+// The current key is read from the cluster, so a retried update does not run the command twice.
+func (r *ResourceShardedCollection) Update(ctx context.Context, plan types.ShardedCollection) error {
+	op := executor.Operation{Name: "update sharded collection"}
+	ns := plan.Namespace()
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		current, err := getShardedCollection(ctx, c, ns)
+		if err != nil {
+			return fmt.Errorf("failed to get sharding metadata: %w", err)
+		}
+
+		if current == nil {
+			return executor.Unrecoverable(fmt.Errorf("collection %s is not sharded", ns))
+		}
+
+		key := shardKeyFromDocument(current.Key)
+
+		switch {
+		case key.Equal(plan.Key):
+			return nil
+		case key.Refines(plan.Key):
+			return c.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+				{Key: "refineCollectionShardKey", Value: ns},
+				{Key: "key", Value: shardKeyDocument(plan.Key)},
+			}).Err()
+		case current.Unique:
+			return executor.Unrecoverable(fmt.Errorf("the unique shard key of %s can only be refined by appending fields, resharding does not support unique keys", ns))
+		}
+
+		cmd := bson.D{
+			{Key: "reshardCollection", Value: ns},
+			{Key: "key", Value: shardKeyDocument(plan.Key)},
+		}
+
+		if plan.NumInitialChunks != nil {
+			cmd = append(cmd, bson.E{Key: "numInitialChunks", Value: *plan.NumInitialChunks})
+		}
+
+		if plan.Collation != nil {
+			cmd = append(cmd, bson.E{Key: "collation", Value: bson.D{{Key: "locale", Value: *plan.Collation}}})
+		}
+
+		return c.Database(types.DefaultDatabase).RunCommand(ctx, cmd).Err()
+	})
+}
+
+// Read refreshes the shard key and the unique flag from config.collections. The other attributes
+// are options of shardCollection which are not stored, so they are kept from the state.
+func (r *ResourceShardedCollection) Read(ctx context.Context, state types.ShardedCollection) (types.ShardedCollection, bool, error) {
+	var current *shardingCollection
+
+	op := executor.Operation{Name: "read sharded collection"}
+
+	err := r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		current, err = getShardedCollection(ctx, c, state.Namespace())
+
+		return err
+	})
+
+	if err != nil || current == nil {
+		return state, false, err
+	}
+
+	state.Key = shardKeyFromDocument(current.Key)
+
+	if state.Unique != nil || current.Unique {
+		state.Unique = &current.Unique
+	}
+
+	return state, true, nil
+}
+
+func (r *ResourceShardedCollection) ImportState(ctx context.Context, namespace string) (types.ShardedCollection, error) {
+	var s types.ShardedCollection
+
+	op := executor.Operation{Name: "import sharded collection"}
+
+	db, collection, ok := splitNamespace(namespace)
+	if !ok {
+		return s, fmt.Errorf("invalid import ID %q, expected <database>.<collection>", namespace)
+	}
+
+	err := r.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		current, err := getShardedCollection(ctx, c, namespace)
+		if err != nil {
+			return fmt.Errorf("failed to get sharding metadata: %w", err)
+		}
+
+		if current == nil {
+			return executor.Unrecoverable(fmt.Errorf("collection %s is not sharded", namespace))
+		}
+
+		s = types.ShardedCollection{
+			Database:   db,
+			Collection: collection,
+			Key:        shardKeyFromDocument(current.Key),
+		}
+
+		if current.Unique {
+			s.Unique = &current.Unique
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return types.ShardedCollection{}, err
+	}
+
+	return s, nil
+}
@@ -0,0 +1,248 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func testShardedCollection() types.ShardedCollection {
+	return types.ShardedCollection{
+		Database:   "app",
+		Collection: "orders",
+		Key: types.ShardKey{
+			{Field: "tenant_id", Type: types.ShardKeyAscending},
+			{Field: "order_id", Type: types.ShardKeyHashed},
+		},
+	}
+}
+
+// configCollection returns a config.collections document of app.orders with the key.
+func configCollection(key bson.D, unique bool) bson.D {
+	return bson.D{
+		{Key: "_id", Value: "app.orders"},
+		{Key: "key", Value: key},
+		{Key: "unique", Value: unique},
+	}
+}
+
+func TestResourceShardedCollectionCreate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+
+	r := &ResourceShardedCollection{Executor: newTestExecutor(srv)}
+
+	chunks := int64(16)
+	collation := "simple"
+	collection := testShardedCollection()
+	collection.NumInitialChunks = &chunks
+	collection.Collation = &collation
+
+	if err := r.Create(context.Background(), collection); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if db := lookup(t, srv.Commands("enableSharding")[0], "enableSharding").StringValue(); db != "app" {
+		t.Fatalf("expected sharding enabled on app, got %s", db)
+	}
+
+	cmds := srv.Commands("shardCollection")
+	if len(cmds) != 1 {
+		t.Fatalf("expected 1 shardCollection, got %d", len(cmds))
+	}
+
+	if ns := lookup(t, cmds[0], "shardCollection").StringValue(); ns != "app.orders" {
+		t.Fatalf("expected namespace app.orders, got %s", ns)
+	}
+
+	if v := lookup(t, cmds[0], "key", "tenant_id").AsInt64(); v != 1 {
+		t.Fatalf("expected ascending tenant_id, got %d", v)
+	}
+
+	if v := lookup(t, cmds[0], "key", "order_id").StringValue(); v != "hashed" {
+		t.Fatalf("expected hashed order_id, got %s", v)
+	}
+
+	if v := lookup(t, cmds[0], "numInitialChunks").AsInt64(); v != chunks {
+		t.Fatalf("expected %d initial chunks, got %d", chunks, v)
+	}
+
+	if v := lookup(t, cmds[0], "collation", "locale").StringValue(); v != collation {
+		t.Fatalf("expected collation %s, got %s", collation, v)
+	}
+
+	if _, err := cmds[0].LookupErr("unique"); err == nil {
+		t.Fatal("expected no unique flag when it is not set")
+	}
+}
+
+func TestResourceShardedCollectionCreateAlreadySharded(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     bson.D
+		unique  bool
+		wantErr bool
+	}{
+		{name: "same key after a lost reply", key: bson.D{{Key: "tenant_id", Value: 1}, {Key: "order_id", Value: "hashed"}}},
+		{name: "another key", key: bson.D{{Key: "tenant_id", Value: 1}}, wantErr: true},
+		{name: "unique key", key: bson.D{{Key: "tenant_id", Value: 1}, {Key: "order_id", Value: "hashed"}}, unique: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.SetMongos(true)
+			srv.Handle("find", mongotest.Cursor(configCollection(tt.key, tt.unique)))
+
+			r := &ResourceShardedCollection{Executor: newTestExecutor(srv)}
+
+			err := r.Create(context.Background(), testShardedCollection())
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "already sharded with another key") {
+					t.Fatalf("expected already sharded error, got %v", err)
+				}
+
+				if srv.Calls("find") != 1 {
+					t.Fatalf("expected no retries, got %d checks", srv.Calls("find"))
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if srv.Calls("shardCollection") != 0 {
+				t.Fatal("shardCollection must not be called")
+			}
+		})
+	}
+}
+
+func TestResourceShardedCollectionUpdate(t *testing.T) {
+	current := bson.D{{Key: "tenant_id", Value: 1}}
+
+	tests := []struct {
+		name        string
+		unique      bool
+		key         types.ShardKey
+		wantCommand string
+		wantErr     string
+	}{
+		{
+			name:        "appended field refines key",
+			key:         testShardedCollection().Key,
+			wantCommand: "refineCollectionShardKey",
+		},
+		{
+			name:        "other key reshards collection",
+			key:         types.ShardKey{{Field: "order_id", Type: types.ShardKeyHashed}},
+			wantCommand: "reshardCollection",
+		},
+		{
+			name:    "unique key is not resharded",
+			unique:  true,
+			key:     types.ShardKey{{Field: "order_id", Type: types.ShardKeyHashed}},
+			wantErr: "resharding does not support unique keys",
+		},
+		{
+			name: "key already changed",
+			key:  types.ShardKey{{Field: "tenant_id", Type: types.ShardKeyAscending}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.SetMongos(true)
+			srv.Handle("find", mongotest.Cursor(configCollection(current, tt.unique)))
+
+			r := &ResourceShardedCollection{Executor: newTestExecutor(srv)}
+
+			collection := testShardedCollection()
+			collection.Key = tt.key
+
+			err := r.Update(context.Background(), collection)
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for _, name := range []string{"refineCollectionShardKey", "reshardCollection"} {
+				want := 0
+				if name == tt.wantCommand {
+					want = 1
+				}
+
+				if calls := srv.Calls(name); calls != want {
+					t.Fatalf("expected %d %s, got %d", want, name, calls)
+				}
+			}
+		})
+	}
+}
+
+func TestResourceShardedCollectionRead(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+
+	r := &ResourceShardedCollection{Executor: newTestExecutor(srv)}
+	ctx := context.Background()
+
+	_, exist, err := r.Read(ctx, testShardedCollection())
+	if err != nil || exist {
+		t.Fatalf("expected unsharded collection, got exist: %t, error: %v", exist, err)
+	}
+
+	srv.Handle("find", mongotest.Cursor(configCollection(bson.D{{Key: "region", Value: "hashed"}}, true)))
+
+	got, exist, err := r.Read(ctx, testShardedCollection())
+	if err != nil || !exist {
+		t.Fatalf("expected sharded collection, got exist: %t, error: %v", exist, err)
+	}
+
+	want := types.ShardKey{{Field: "region", Type: types.ShardKeyHashed}}
+	if !got.Key.Equal(want) {
+		t.Fatalf("expected key %v, got %v", want, got.Key)
+	}
+
+	if got.Unique == nil || !*got.Unique {
+		t.Fatal("expected unique key")
+	}
+
+	filter := lookup(t, srv.Commands("find")[1], "filter", "_id").StringValue()
+	if filter != "app.orders" {
+		t.Fatalf("expected lookup of app.orders, got %s", filter)
+	}
+}
+
+func TestResourceShardedCollectionImportState(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+	srv.Handle("find", mongotest.Cursor(configCollection(bson.D{{Key: "tenant_id", Value: 1}}, false)))
+
+	r := &ResourceShardedCollection{Executor: newTestExecutor(srv)}
+
+	got, err := r.ImportState(context.Background(), "app.orders")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Database != "app" || got.Collection != "orders" || got.Unique != nil {
+		t.Fatalf("unexpected import %+v", got)
+	}
+
+	if _, err := r.ImportState(context.Background(), "orders"); err == nil {
+		t.Fatal("expected invalid import ID error")
+	}
+}
@@ -0,0 +1,203 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// topologyShard is a shard as returned by listShards.
+type topologyShard struct {
+	ID       string   `bson:"_id"`
+	Host     string   `bson:"host"`
+	Draining bool     `bson:"draining"`
+	Tags     []string `bson:"tags"`
+}
+
+// topologyCollection is a sharded collection in config.collections.
+type topologyCollection struct {
+	ID        string      `bson:"_id"`
+	Key       bson.D      `bson:"key"`
+	Unique    bool        `bson:"unique"`
+	NoBalance bool        `bson:"noBalance"`
+	UUID      bson.Binary `bson:"uuid"`
+}
+
+// chunkCount is the number of chunks of a collection on a shard.
+type chunkCount struct {
+	ID struct {
+		UUID  bson.Binary `bson:"uuid"`
+		Shard string      `bson:"shard"`
+	} `bson:"_id"`
+	Count int64 `bson:"count"`
+}
+
+func (d *DataSourceSharding) Read(ctx context.Context) (types.Sharding, error) {
+	var s types.Sharding
+
+	op := executor.Operation{Name: "read sharding"}
+
+	err := d.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		s, err = getSharding(ctx, c)
+
+		return err
+	})
+
+	return s, err
+}
+
+// getSharding returns the topology of the cluster from listShards, the config database and balancerStatus.
+func getSharding(ctx context.Context, client *mongo.Client) (types.Sharding, error) {
+	var shards struct {
+		Shards []topologyShard `bson:"shards"`
+	}
+
+	err := client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{{Key: "listShards", Value: 1}}).Decode(&shards)
+	if err != nil {
+		return types.Sharding{}, fmt.Errorf("list shards failed with error: %w", err)
+	}
+
+	config := client.Database(types.ConfigDatabase)
+
+	var databases []struct {
+		ID      string `bson:"_id"`
+		Primary string `bson:"primary"`
+	}
+
+	cursor, err := config.Collection("databases").Find(ctx, bson.D{})
+	if err == nil {
+		err = cursor.All(ctx, &databases)
+	}
+	if err != nil {
+		return types.Sharding{}, fmt.Errorf("failed to list databases: %w", err)
+	}
+
+	var collections []topologyCollection
+
+	cursor, err = config.Collection("collections").Find(ctx, bson.D{})
+	if err == nil {
+		err = cursor.All(ctx, &collections)
+	}
+	if err != nil {
+		return types.Sharding{}, fmt.Errorf("failed to list sharded collections: %w", err)
+	}
+
+	// Chunks refer to their collection by UUID since MongoDB 5.0
+	var chunks []chunkCount
+
+	cursor, err = config.Collection("chunks").Aggregate(ctx, bson.A{
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "uuid", Value: "$uuid"}, {Key: "shard", Value: "$shard"}}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	})
+	if err == nil {
+		err = cursor.All(ctx, &chunks)
+	}
+	if err != nil {
+		return types.Sharding{}, fmt.Errorf("failed to count chunks: %w", err)
+	}
+
+	var status struct {
+		Mode            string `bson:"mode"`
+		InBalancerRound bool   `bson:"inBalancerRound"`
+	}
+
+	err = client.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{{Key: "balancerStatus", Value: 1}}).Decode(&status)
+	if err != nil {
+		return types.Sharding{}, fmt.Errorf("failed to get balancer status: %w", err)
+	}
+
+	s := types.Sharding{
+		Shards:      []types.ShardingShard{},
+		Databases:   []types.ShardingDatabase{},
+		Collections: []types.ShardingCollection{},
+		Balancer:    types.BalancerStatus{Mode: status.Mode, InBalancerRound: status.InBalancerRound},
+	}
+
+	shardChunks := map[string]int64{}
+	collectionChunks := map[string]map[string]int64{}
+
+	for _, c := range chunks {
+		uuid := string(c.ID.UUID.Data)
+
+		if collectionChunks[uuid] == nil {
+			collectionChunks[uuid] = map[string]int64{}
+		}
+
+		collectionChunks[uuid][c.ID.Shard] += c.Count
+		shardChunks[c.ID.Shard] += c.Count
+	}
+
+	for _, shard := range shards.Shards {
+		state := types.ShardStateActive
+		if shard.Draining {
+			state = types.ShardStateDraining
+		}
+
+		zones := shard.Tags
+		if zones == nil {
+			zones = []string{}
+		}
+
+		s.Shards = append(s.Shards, types.ShardingShard{
+			Name:   shard.ID,
+			Host:   shard.Host,
+			State:  state,
+			Zones:  zones,
+			Chunks: shardChunks[shard.ID],
+		})
+	}
+
+	for _, database := range databases {
+		if isDefaultDatabase(database.ID) {
+			continue
+		}
+
+		s.Databases = append(s.Databases, types.ShardingDatabase{Name: database.ID, PrimaryShard: database.Primary})
+	}
+
+	for _, collection := range collections {
+		db, name, ok := splitNamespace(collection.ID)
+		if !ok || isDefaultDatabase(db) {
+			continue
+		}
+
+		counts := collectionChunks[string(collection.UUID.Data)]
+		if counts == nil {
+			counts = map[string]int64{}
+		}
+
+		s.Collections = append(s.Collections, types.ShardingCollection{
+			Database:   db,
+			Collection: name,
+			Key:        shardKeyFromDocument(collection.Key),
+			Unique:     collection.Unique,
+			Balancing:  !collection.NoBalance,
+			Chunks:     counts,
+		})
+	}
+
+	sort.Slice(s.Shards, func(i, j int) bool { return s.Shards[i].Name < s.Shards[j].Name })
+	sort.Slice(s.Databases, func(i, j int) bool { return s.Databases[i].Name < s.Databases[j].Name })
+	sort.Slice(s.Collections, func(i, j int) bool {
+		if s.Collections[i].Database != s.Collections[j].Database {
+			return s.Collections[i].Database < s.Collections[j].Database
+		}
+
+		return s.Collections[i].Collection < s.Collections[j].Collection
+	})
+
+	return s, nil
+}
@@ -0,0 +1,99 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestDataSourceShardingRead(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+
+	orders := bson.Binary{Subtype: bson.TypeBinaryUUID, Data: []byte("0123456789abcdef")}
+	sessions := bson.Binary{Subtype: bson.TypeBinaryUUID, Data: []byte("fedcba9876543210")}
+
+	srv.Handle("listShards", mongotest.Reply(bson.E{Key: "shards", Value: bson.A{
+		bson.D{{Key: "_id", Value: "shard02"}, {Key: "host", Value: "rs2/db2:27017"}, {Key: "state", Value: 1}, {Key: "draining", Value: true}},
+		bson.D{{Key: "_id", Value: "shard01"}, {Key: "host", Value: "rs1/db1:27017"}, {Key: "state", Value: 1}, {Key: "tags", Value: bson.A{"EU"}}},
+	}}))
+
+	config := mongotest.Collections(map[string][]bson.D{
+		"config.databases": {
+			{{Key: "_id", Value: "app"}, {Key: "primary", Value: "shard01"}},
+			{{Key: "_id", Value: "config"}, {Key: "primary", Value: "config"}},
+		},
+		"config.collections": {
+			{{Key: "_id", Value: "app.orders"}, {Key: "key", Value: bson.D{{Key: "tenant_id", Value: 1}, {Key: "_id", Value: "hashed"}}}, {Key: "unique", Value: false}, {Key: "noBalance", Value: true}, {Key: "uuid", Value: orders}},
+			{{Key: "_id", Value: "config.system.sessions"}, {Key: "key", Value: bson.D{{Key: "_id", Value: 1}}}, {Key: "uuid", Value: sessions}},
+		},
+		"config.chunks": {
+			{{Key: "_id", Value: bson.D{{Key: "uuid", Value: orders}, {Key: "shard", Value: "shard01"}}}, {Key: "count", Value: int32(3)}},
+			{{Key: "_id", Value: bson.D{{Key: "uuid", Value: orders}, {Key: "shard", Value: "shard02"}}}, {Key: "count", Value: int32(2)}},
+			{{Key: "_id", Value: bson.D{{Key: "uuid", Value: sessions}, {Key: "shard", Value: "shard01"}}}, {Key: "count", Value: int32(1024)}},
+		},
+	})
+	srv.Handle("find", config)
+	srv.Handle("aggregate", config)
+
+	d := &DataSourceSharding{Executor: newTestExecutor(srv)}
+
+	got, err := d.Read(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got.Shards) != 2 || got.Shards[0].Name != "shard01" || got.Shards[1].State != types.ShardStateDraining {
+		t.Fatalf("expected shard01 and draining shard02, got %+v", got.Shards)
+	}
+
+	if got.Shards[0].Chunks != 1027 || got.Shards[1].Chunks != 2 || got.Shards[0].Zones[0] != "EU" {
+		t.Fatalf("expected chunks and zones of all collections on the shards, got %+v", got.Shards)
+	}
+
+	if len(got.Databases) != 1 || got.Databases[0] != (types.ShardingDatabase{Name: "app", PrimaryShard: "shard01"}) {
+		t.Fatalf("expected database app on shard01, got %+v", got.Databases)
+	}
+
+	if len(got.Collections) != 1 {
+		t.Fatalf("expected only app.orders, got %+v", got.Collections)
+	}
+
+	c := got.Collections[0]
+	if c.Collection != "orders" || c.Balancing || c.Chunks["shard01"] != 3 || c.Chunks["shard02"] != 2 {
+		t.Fatalf("expected app.orders without balancing and 3+2 chunks, got %+v", c)
+	}
+
+	if !c.Key.Equal(types.ShardKey{{Field: "tenant_id", Type: types.ShardKeyAscending}, {Field: "_id", Type: types.ShardKeyHashed}}) {
+		t.Fatalf("unexpected shard key %+v", c.Key)
+	}
+
+	if got.Balancer.Mode != "full" || got.Balancer.InBalancerRound {
+		t.Fatalf("expected idle balancer, got %+v", got.Balancer)
+	}
+
+	group := lookup(t, srv.Commands("aggregate")[0], "pipeline", "0", "$group", "_id")
+	if !strings.Contains(group.String(), "$uuid") {
+		t.Fatalf("expected chunks grouped by collection uuid, got %s", group)
+	}
+}
+
+func TestDataSourceShardingRequiresMongos(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	d := &DataSourceSharding{Executor: newTestExecutor(srv)}
+
+	_, err := d.Read(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "mongos") {
+		t.Fatalf("expected mongos error, got %v", err)
+	}
+
+	if srv.Calls("listShards") != 0 {
+		t.Fatal("expected no sharding commands")
+	}
+}
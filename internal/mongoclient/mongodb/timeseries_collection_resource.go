@@ -0,0 +1,252 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// timeSeriesOptions are the options of a time-series collection reported by listCollections.
+type timeSeriesOptions struct {
+	TimeSeries struct {
+		TimeField             string  `bson:"timeField"`
+		MetaField             *string `bson:"metaField"`
+		Granularity           *string `bson:"granularity"`
+		BucketMaxSpanSeconds  *int64  `bson:"bucketMaxSpanSeconds"`
+		BucketRoundingSeconds *int64  `bson:"bucketRoundingSeconds"`
+	} `bson:"timeseries"`
+	ExpireAfterSeconds *int64 `bson:"expireAfterSeconds"`
+}
+
+func (r *ResourceTimeSeriesCollection) Create(ctx context.Context, plan types.TimeSeriesCollection) error {
+	err := validateTimeSeriesBuckets(plan)
+	if err != nil {
+		return executor.Unrecoverable(err)
+	}
+
+	timeseries := bson.D{{Key: "timeField", Value: plan.TimeField}}
+
+	if plan.MetaField != nil {
+		timeseries = append(timeseries, bson.E{Key: "metaField", Value: *plan.MetaField})
+	}
+
+	timeseries = append(timeseries, timeSeriesBucketing(plan)...)
+
+	cmd := bson.D{
+		{Key: "create", Value: plan.Name},
+		{Key: "timeseries", Value: timeseries},
+	}
+
+	if plan.ExpireAfterSeconds != nil {
+		cmd = append(cmd, bson.E{Key: "expireAfterSeconds", Value: *plan.ExpireAfterSeconds})
+	}
+
+	op := executor.Operation{Name: "create time-series collection"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireTimeSeriesVersion(ctx, c, plan)
+		if err != nil {
+			return err
+		}
+
+		err = c.Database(plan.Database).RunCommand(ctx, cmd).Err()
+		if err != nil {
+			return fmt.Errorf("create time-series collection failed with error: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// Update changes the bucketing and the expiration of the collection in place with collMod. MongoDB
+// only allows coarser granularities and larger buckets, other changes replace the collection.
+func (r *ResourceTimeSeriesCollection) Update(ctx context.Context, plan types.TimeSeriesCollection) error {
+	err := validateTimeSeriesBuckets(plan)
+	if err != nil {
+		return executor.Unrecoverable(err)
+	}
+
+	var expireAfterSeconds interface{} = "off"
+	if plan.ExpireAfterSeconds != nil {
+		expireAfterSeconds = *plan.ExpireAfterSeconds
+	}
+
+	op := executor.Operation{Name: "update time-series collection"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		if bucketing := timeSeriesBucketing(plan); len(bucketing) > 0 {
+			err := requireTimeSeriesVersion(ctx, c, plan)
+			if err != nil {
+				return err
+			}
+
+			err = c.Database(plan.Database).RunCommand(ctx, bson.D{
+				{Key: "collMod", Value: plan.Name},
+				{Key: "timeseries", Value: bucketing},
+			}).Err()
+			if err != nil {
+				return fmt.Errorf("collMod failed with error: %w", err)
+			}
+		}
+
+		err := c.Database(plan.Database).RunCommand(ctx, bson.D{
+			{Key: "collMod", Value: plan.Name},
+			{Key: "expireAfterSeconds", Value: expireAfterSeconds},
+		}).Err()
+		if err != nil {
+			return fmt.Errorf("collMod failed with error: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *ResourceTimeSeriesCollection) Delete(ctx context.Context, state types.TimeSeriesCollection) error {
+	op := executor.Operation{Name: "delete time-series collection"}
+
+	return r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		return dropCollection(ctx, c, state.Database, state.Name)
+	})
+}
+
+// Read returns the definition of the collection from listCollections. The default seconds granularity
+// is kept unset if it is unset in the state. It returns false if the collection does not exist.
+func (r *ResourceTimeSeriesCollection) Read(ctx context.Context, state types.TimeSeriesCollection) (types.TimeSeriesCollection, bool, error) {
+	var current *types.TimeSeriesCollection
+
+	op := executor.Operation{Name: "read time-series collection"}
+
+	err := r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		var err error
+
+		current, err = getTimeSeriesCollection(ctx, c, state.Database, state.Name)
+
+		return err
+	})
+	if err != nil {
+		return state, false, err
+	}
+
+	if current == nil {
+		return state, false, nil
+	}
+
+	state.TimeField = current.TimeField
+	state.MetaField = current.MetaField
+	state.BucketMaxSpanSeconds = current.BucketMaxSpanSeconds
+	state.BucketRoundingSeconds = current.BucketRoundingSeconds
+	state.ExpireAfterSeconds = current.ExpireAfterSeconds
+
+	defaultGranularity := current.Granularity != nil && *current.Granularity == types.TimeSeriesGranularitySeconds
+	if state.Granularity != nil || !defaultGranularity {
+		state.Granularity = current.Granularity
+	}
+
+	return state, true, nil
+}
+
+// ImportState imports the collection by the <database>.<name> namespace.
+func (r *ResourceTimeSeriesCollection) ImportState(ctx context.Context, namespace string) (types.TimeSeriesCollection, error) {
+	var current *types.TimeSeriesCollection
+
+	db, name, ok := splitNamespace(namespace)
+	if !ok {
+		return types.TimeSeriesCollection{}, fmt.Errorf("invalid import ID %q, expected <database>.<collection>", namespace)
+	}
+
+	op := executor.Operation{Name: "import time-series collection"}
+
+	err := r.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		var err error
+
+		current, err = getTimeSeriesCollection(ctx, c, db, name)
+
+		return err
+	})
+	if err != nil {
+		return types.TimeSeriesCollection{}, err
+	}
+
+	if current == nil {
+		return types.TimeSeriesCollection{}, fmt.Errorf("time-series collection %s does not exist", namespace)
+	}
+
+	return *current, nil
+}
+
+// getTimeSeriesCollection returns the collection from listCollections, or nil if there is no collection
+// or view with the name. The bucket settings are returned only if they replace the granularity.
+func getTimeSeriesCollection(ctx context.Context, client *mongo.Client, database, name string) (*types.TimeSeriesCollection, error) {
+	var opts timeSeriesOptions
+
+	found, err := getCollectionOptions(ctx, client, database, name, collectionTypeTimeSeries, &opts)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	c := &types.TimeSeriesCollection{
+		Database:           database,
+		Name:               name,
+		TimeField:          opts.TimeSeries.TimeField,
+		MetaField:          opts.TimeSeries.MetaField,
+		ExpireAfterSeconds: opts.ExpireAfterSeconds,
+	}
+
+	// MongoDB reports the maximum span of the granularity too, but the rounding only for custom buckets.
+	if opts.TimeSeries.BucketRoundingSeconds != nil {
+		c.BucketMaxSpanSeconds = opts.TimeSeries.BucketMaxSpanSeconds
+		c.BucketRoundingSeconds = opts.TimeSeries.BucketRoundingSeconds
+	} else {
+		c.Granularity = opts.TimeSeries.Granularity
+	}
+
+	return c, nil
+}
+
+// timeSeriesBucketing returns the granularity or the bucket settings of the timeseries options.
+// It is empty if neither is set, i.e. for the default granularity.
+func timeSeriesBucketing(c types.TimeSeriesCollection) bson.D {
+	if c.Granularity != nil {
+		return bson.D{{Key: "granularity", Value: *c.Granularity}}
+	}
+
+	if c.HasCustomBuckets() {
+		return bson.D{
+			{Key: "bucketMaxSpanSeconds", Value: *c.BucketMaxSpanSeconds},
+			{Key: "bucketRoundingSeconds", Value: *c.BucketRoundingSeconds},
+		}
+	}
+
+	return nil
+}
+
+// validateTimeSeriesBuckets checks the bucket settings, MongoDB requires both with the same value.
+func validateTimeSeriesBuckets(c types.TimeSeriesCollection) error {
+	if !c.HasCustomBuckets() {
+		return nil
+	}
+
+	if c.Granularity != nil {
+		return fmt.Errorf("granularity cannot be combined with the bucket settings")
+	}
+
+	if c.BucketMaxSpanSeconds == nil || c.BucketRoundingSeconds == nil || *c.BucketMaxSpanSeconds != *c.BucketRoundingSeconds {
+		return fmt.Errorf("bucket_max_span_seconds and bucket_rounding_seconds must be set to the same value")
+	}
+
+	return nil
+}
+
+// requireTimeSeriesVersion checks if the server supports time-series collections and, if they are used, the bucket settings.
+func requireTimeSeriesVersion(ctx context.Context, client *mongo.Client, c types.TimeSeriesCollection) error {
+	if c.HasCustomBuckets() {
+		return requireServerVersion(ctx, client, 6, 3, "custom bucketing")
+	}
+
+	return requireServerVersion(ctx, client, 5, 0, "time-series collections")
+}
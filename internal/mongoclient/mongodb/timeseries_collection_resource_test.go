@@ -0,0 +1,327 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// weatherTimeSeries is the listCollections entry of a time-series collection of weather measurements.
+func weatherTimeSeries(timeseries bson.D) bson.D {
+	return bson.D{
+		{Key: "name", Value: "weather"},
+		{Key: "type", Value: "timeseries"},
+		{Key: "options", Value: bson.D{
+			{Key: "timeseries", Value: append(bson.D{
+				{Key: "timeField", Value: "ts"},
+				{Key: "metaField", Value: "sensor"},
+			}, timeseries...)},
+			{Key: "expireAfterSeconds", Value: int64(86400)},
+		}},
+	}
+}
+
+func TestResourceTimeSeriesCollectionCreate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceTimeSeriesCollection{Executor: newTestExecutor(srv)}
+
+	meta, granularity, expire := "sensor", types.TimeSeriesGranularityMinutes, int64(86400)
+	plan := types.TimeSeriesCollection{
+		Database:           "metrics",
+		Name:               "weather",
+		TimeField:          "ts",
+		MetaField:          &meta,
+		Granularity:        &granularity,
+		ExpireAfterSeconds: &expire,
+	}
+
+	if err := r.Create(context.Background(), plan); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmd := srv.Commands("create")[0]
+
+	if db := lookup(t, cmd, "$db").StringValue(); db != "metrics" {
+		t.Fatalf("expected create on metrics, got %s", db)
+	}
+
+	if v := lookup(t, cmd, "timeseries", "timeField").StringValue(); v != "ts" {
+		t.Fatalf("expected timeField ts, got %s", v)
+	}
+
+	if v := lookup(t, cmd, "timeseries", "metaField").StringValue(); v != "sensor" {
+		t.Fatalf("expected metaField sensor, got %s", v)
+	}
+
+	if v := lookup(t, cmd, "timeseries", "granularity").StringValue(); v != "minutes" {
+		t.Fatalf("expected granularity minutes, got %s", v)
+	}
+
+	if v := lookup(t, cmd, "expireAfterSeconds").Int64(); v != 86400 {
+		t.Fatalf("expected expireAfterSeconds 86400, got %d", v)
+	}
+}
+
+func TestResourceTimeSeriesCollectionCreateBuckets(t *testing.T) {
+	tests := []struct {
+		name         string
+		versionArray bson.A
+		maxSpan      int64
+		rounding     int64
+		wantErr      string
+	}{
+		{
+			name:         "supported",
+			versionArray: bson.A{7, 0, 2, 0},
+			maxSpan:      7200,
+			rounding:     7200,
+		},
+		{
+			name:         "unsupported version",
+			versionArray: bson.A{6, 0, 5, 0},
+			maxSpan:      7200,
+			rounding:     7200,
+			wantErr:      "custom bucketing requires MongoDB 6.3 or later",
+		},
+		{
+			name:         "different values",
+			versionArray: bson.A{7, 0, 2, 0},
+			maxSpan:      7200,
+			rounding:     3600,
+			wantErr:      "must be set to the same value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.Handle("buildInfo", mongotest.Reply(bson.E{Key: "versionArray", Value: tt.versionArray}))
+
+			r := &ResourceTimeSeriesCollection{Executor: newTestExecutor(srv)}
+
+			err := r.Create(context.Background(), types.TimeSeriesCollection{
+				Database:              "metrics",
+				Name:                  "weather",
+				TimeField:             "ts",
+				BucketMaxSpanSeconds:  &tt.maxSpan,
+				BucketRoundingSeconds: &tt.rounding,
+			})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+
+				if srv.Calls("create") != 0 {
+					t.Fatal("expected no create")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			cmd := srv.Commands("create")[0]
+
+			if v := lookup(t, cmd, "timeseries", "bucketMaxSpanSeconds").Int64(); v != 7200 {
+				t.Fatalf("expected bucketMaxSpanSeconds 7200, got %d", v)
+			}
+
+			if _, err := cmd.LookupErr("timeseries", "granularity"); err == nil {
+				t.Fatal("expected no granularity with the bucket settings")
+			}
+		})
+	}
+}
+
+func TestResourceTimeSeriesCollectionUpdate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceTimeSeriesCollection{Executor: newTestExecutor(srv)}
+
+	granularity := types.TimeSeriesGranularityHours
+	plan := types.TimeSeriesCollection{Database: "metrics", Name: "weather", TimeField: "ts", Granularity: &granularity}
+
+	if err := r.Update(context.Background(), plan); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmds := srv.Commands("collMod")
+	if len(cmds) != 2 {
+		t.Fatalf("expected 2 collMod commands, got %d", len(cmds))
+	}
+
+	if v := lookup(t, cmds[0], "timeseries", "granularity").StringValue(); v != "hours" {
+		t.Fatalf("expected granularity hours, got %s", v)
+	}
+
+	if v := lookup(t, cmds[1], "expireAfterSeconds").StringValue(); v != "off" {
+		t.Fatalf("expected expiration off, got %s", v)
+	}
+}
+
+func TestResourceTimeSeriesCollectionUpdateExpiration(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceTimeSeriesCollection{Executor: newTestExecutor(srv)}
+
+	expire := int64(3600)
+	plan := types.TimeSeriesCollection{Database: "metrics", Name: "weather", TimeField: "ts", ExpireAfterSeconds: &expire}
+
+	if err := r.Update(context.Background(), plan); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmds := srv.Commands("collMod")
+	if len(cmds) != 1 {
+		t.Fatalf("expected only the expiration collMod for the default granularity, got %d commands", len(cmds))
+	}
+
+	if v := lookup(t, cmds[0], "expireAfterSeconds").Int64(); v != 3600 {
+		t.Fatalf("expected expireAfterSeconds 3600, got %d", v)
+	}
+}
+
+func TestResourceTimeSeriesCollectionDelete(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceTimeSeriesCollection{Executor: newTestExecutor(srv)}
+
+	if err := r.Delete(context.Background(), types.TimeSeriesCollection{Database: "metrics", Name: "weather"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if v := lookup(t, srv.Commands("drop")[0], "drop").StringValue(); v != "weather" {
+		t.Fatalf("expected drop of weather, got %s", v)
+	}
+}
+
+func TestResourceTimeSeriesCollectionRead(t *testing.T) {
+	seconds := types.TimeSeriesGranularitySeconds
+
+	tests := []struct {
+		name             string
+		collections      []bson.D
+		stateGranularity *string
+		wantExist        bool
+		wantGranularity  string
+		wantBuckets      int64
+		wantErr          string
+	}{
+		{
+			name: "default granularity",
+			collections: []bson.D{weatherTimeSeries(bson.D{
+				{Key: "granularity", Value: "seconds"},
+				{Key: "bucketMaxSpanSeconds", Value: int32(3600)},
+			})},
+			wantExist: true,
+		},
+		{
+			name: "granularity in state",
+			collections: []bson.D{weatherTimeSeries(bson.D{
+				{Key: "granularity", Value: "seconds"},
+				{Key: "bucketMaxSpanSeconds", Value: int32(3600)},
+			})},
+			stateGranularity: &seconds,
+			wantExist:        true,
+			wantGranularity:  "seconds",
+		},
+		{
+			name: "granularity changed outside terraform",
+			collections: []bson.D{weatherTimeSeries(bson.D{
+				{Key: "granularity", Value: "hours"},
+				{Key: "bucketMaxSpanSeconds", Value: int32(2592000)},
+			})},
+			wantExist:       true,
+			wantGranularity: "hours",
+		},
+		{
+			name: "custom buckets",
+			collections: []bson.D{weatherTimeSeries(bson.D{
+				{Key: "bucketRoundingSeconds", Value: int32(7200)},
+				{Key: "bucketMaxSpanSeconds", Value: int32(7200)},
+			})},
+			wantExist:   true,
+			wantBuckets: 7200,
+		},
+		{
+			name: "collection dropped",
+		},
+		{
+			name:        "view",
+			collections: []bson.D{{{Key: "name", Value: "weather"}, {Key: "type", Value: "view"}, {Key: "options", Value: bson.D{}}}},
+			wantErr:     "is a view, not a timeseries",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.Handle("listCollections", mongotest.Cursor(tt.collections...))
+
+			r := &ResourceTimeSeriesCollection{Executor: newTestExecutor(srv)}
+
+			state := types.TimeSeriesCollection{Database: "metrics", Name: "weather", TimeField: "ts", Granularity: tt.stateGranularity}
+
+			got, exist, err := r.Read(context.Background(), state)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if exist != tt.wantExist {
+				t.Fatalf("expected exist %t, got %t", tt.wantExist, exist)
+			}
+
+			if !exist {
+				return
+			}
+
+			if got.MetaField == nil || *got.MetaField != "sensor" || got.ExpireAfterSeconds == nil || *got.ExpireAfterSeconds != 86400 {
+				t.Fatalf("expected meta field sensor expiring after 86400 seconds, got %+v", got)
+			}
+
+			if (tt.wantGranularity == "") != (got.Granularity == nil) || got.Granularity != nil && *got.Granularity != tt.wantGranularity {
+				t.Fatalf("expected granularity %q, got %v", tt.wantGranularity, got.Granularity)
+			}
+
+			if (tt.wantBuckets == 0) != (got.BucketMaxSpanSeconds == nil) || got.BucketRoundingSeconds != nil && *got.BucketRoundingSeconds != tt.wantBuckets {
+				t.Fatalf("expected buckets of %d seconds, got %+v", tt.wantBuckets, got)
+			}
+		})
+	}
+}
+
+func TestResourceTimeSeriesCollectionImportState(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("listCollections", mongotest.Cursor(weatherTimeSeries(bson.D{{Key: "granularity", Value: "minutes"}})))
+
+	r := &ResourceTimeSeriesCollection{Executor: newTestExecutor(srv)}
+
+	got, err := r.ImportState(context.Background(), "metrics.weather")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Database != "metrics" || got.Name != "weather" || got.TimeField != "ts" || got.Granularity == nil || *got.Granularity != "minutes" {
+		t.Fatalf("expected weather collection with minutes granularity, got %+v", got)
+	}
+
+	if _, err := r.ImportState(context.Background(), "weather"); err == nil {
+		t.Fatal("expected invalid import ID error")
+	}
+}
@@ -4,86 +4,52 @@ import (
 	"context"
 	"fmt"
 
+	"terraform-provider-mongodb/internal/mongoclient/executor"
 	"terraform-provider-mongodb/internal/mongoclient/types"
 
-	"github.com/avast/retry-go/v4"
 	"go.mongodb.org/mongo-driver/v2/mongo"
-	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 func (d *DataSourceUser) Read(ctx context.Context) (types.Users, error) {
 	us := types.Users{}
 
-	err := retry.Do(
-		func() error {
-			c, err := d.connect(ctx)
-			if err != nil {
-				return fmt.Errorf("connection to MongoDB failed with error: %s", err)
-			}
-
-			defer func() {
-				disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-				_ = c.Disconnect(disconnectCtx)
-				cancel()
-			}()
+	op := executor.Operation{Name: "read users"}
 
-			list, err := listUsers(ctx, c)
-			if err != nil {
-				return fmt.Errorf("list users failed with error: %s", err)
-			}
+	err := d.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		us = types.Users{}
 
-			for _, i := range list.Users {
-				if isDefaultUser(i.Username) {
-					continue
-				}
+		list, err := listUsers(ctx, c)
+		if err != nil {
+			return fmt.Errorf("list users failed with error: %w", err)
+		}
 
-				r := make([]types.Role, 0, len(i.Roles))
+		for _, i := range list.Users {
+			if isDefaultUser(i.Username) {
+				continue
+			}
 
-				for _, j := range i.Roles {
-					r = append(r, types.Role{
-						Role:     j.Role,
-						Database: j.Database,
-					})
-				}
+			r := make([]types.Role, 0, len(i.Roles))
 
-				us.Users = append(us.Users, types.User{
-					Username: i.Username,
-					Password: i.Password,
-					Roles:    r,
+			for _, j := range i.Roles {
+				r = append(r, types.Role{
+					Role:     j.Role,
+					Database: j.Database,
 				})
 			}
 
-			if len(us.Users) == 0 {
-				return retry.Unrecoverable(fmt.Errorf("users not found. You can create a user using resource mongodb_user"))
-			}
-
-			return nil
-		},
-		retry.Attempts(d.RetryAttempts),
-		retry.DelayType(retry.BackOffDelay),
-		retry.Delay(d.RetryDelay),
-		retry.Context(ctx),
-	)
+			us.Users = append(us.Users, types.User{
+				Username: i.Username,
+				Password: i.Password,
+				Roles:    r,
+			})
+		}
 
-	return us, err
-}
-
-func (d *DataSourceUser) connect(ctx context.Context) (*mongo.Client, error) {
-	opts := options.Client().ApplyURI(d.Uri)
+		if len(us.Users) == 0 {
+			return executor.Unrecoverable(fmt.Errorf("users not found. You can create a user using resource mongodb_user"))
+		}
 
-	client, err := mongo.Connect(opts)
-	if err != nil {
-		return nil, err
-	}
+		return nil
+	})
 
-	err = client.Ping(ctx, nil)
-	if err != nil {
-		disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-		_ = client.Disconnect(disconnectCtx)
-		cancel()
-
-		return nil, fmt.Errorf("failed to ping MongoDB: %s", err)
-	}
-
-	return client, nil
+	return us, err
 }
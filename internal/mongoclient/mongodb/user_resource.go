@@ -4,12 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"terraform-provider-mongodb/internal/mongoclient/executor"
 	"terraform-provider-mongodb/internal/mongoclient/types"
 
-	"github.com/avast/retry-go/v4"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
-	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 func (r *ResourceUser) Create(ctx context.Context, plan types.User) error {
@@ -17,80 +16,48 @@ func (r *ResourceUser) Create(ctx context.Context, plan types.User) error {
 		return fmt.Errorf("user %s is a default user and cannot be created", plan.Username)
 	}
 
-	err := retry.Do(
-		func() error {
-			c, err := r.connect(ctx)
-			if err != nil {
-				return err
-			}
-
-			defer func() {
-				disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-				_ = c.Disconnect(disconnectCtx)
-				cancel()
-			}()
-
-			exist, err := userExists(ctx, c, plan.Username)
-			if err != nil {
-				return fmt.Errorf("failed to check if user exists: %s", err)
-			}
-
-			if exist {
-				return retry.Unrecoverable(fmt.Errorf("user %s already exists", plan.Username))
-			}
-
-			roles := make([]bson.M, 0, len(plan.Roles))
-			for _, i := range plan.Roles {
-				roles = append(roles, bson.M{
-					"role": i.Role,
-					"db":   i.Database,
-				})
-			}
-
-			command := bson.D{
-				{"createUser", plan.Username},
-				{"pwd", plan.Password},
-				{"roles", roles},
-			}
-
-			return c.Database(types.DefaultDatabase).RunCommand(ctx, command).Err()
-		},
-		retry.Attempts(r.RetryAttempts),
-		retry.DelayType(retry.BackOffDelay),
-		retry.Delay(r.RetryDelay),
-		retry.Context(ctx),
-	)
-
-	return err
+	op := executor.Operation{Name: "create user"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		exist, err := userExists(ctx, c, plan.Username)
+		if err != nil {
+			return fmt.Errorf("failed to check if user exists: %w", err)
+		}
+
+		if exist {
+			return executor.Unrecoverable(fmt.Errorf("user %s already exists", plan.Username))
+		}
+
+		roles := make([]bson.M, 0, len(plan.Roles))
+		for _, i := range plan.Roles {
+			roles = append(roles, bson.M{
+				"role": i.Role,
+				"db":   i.Database,
+			})
+		}
+
+		command := bson.D{
+			{Key: "createUser", Value: plan.Username},
+			{Key: "pwd", Value: plan.Password},
+			{Key: "roles", Value: roles},
+		}
+
+		return c.Database(types.DefaultDatabase).RunCommand(ctx, command).Err()
+	})
 }
 
 func (r *ResourceUser) Exists(ctx context.Context, state types.User) (bool, error) {
 	var exist bool
 
-	err := retry.Do(
-		func() error {
-			var err error
+	op := executor.Operation{Name: "check user existence"}
 
-			c, err := r.connect(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to connect to MongoDB: %s", err)
-			}
+	err := r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		var err error
 
-			defer func() {
-				disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-				_ = c.Disconnect(disconnectCtx)
-				cancel()
-			}()
+		exist, err = userExists(ctx, c, state.Username)
 
-			exist, err = userExists(ctx, c, state.Username)
-
-			return err
-		},
-		retry.Attempts(r.RetryAttempts),
-		retry.DelayType(retry.BackOffDelay),
-		retry.Delay(r.RetryDelay),
-		retry.Context(ctx),
-	)
+		return err
+	})
 
 	return exist, err
 }
@@ -100,39 +67,22 @@ func (r *ResourceUser) Delete(ctx context.Context, state types.User) error {
 		return fmt.Errorf("user %s is a default user and cannot be deleted", state.Username)
 	}
 
-	err := retry.Do(
-		func() error {
-			c, err := r.connect(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to connect to MongoDB: %s", err)
-			}
-
-			defer func() {
-				disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-				_ = c.Disconnect(disconnectCtx)
-				cancel()
-			}()
-
-			exist, err := userExists(ctx, c, state.Username)
-			if err != nil {
-				return fmt.Errorf("failed to check if user exists: %s", err)
-			}
-
-			if !exist {
-				return retry.Unrecoverable(fmt.Errorf("user %s does not exist", state.Username))
-			}
-
-			return c.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
-				{"dropUser", state.Username},
-			}).Err()
-		},
-		retry.Attempts(r.RetryAttempts),
-		retry.DelayType(retry.BackOffDelay),
-		retry.Delay(r.RetryDelay),
-		retry.Context(ctx),
-	)
-
-	return err
+	op := executor.Operation{Name: "delete user"}
+
+	return r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		exist, err := userExists(ctx, c, state.Username)
+		if err != nil {
+			return fmt.Errorf("failed to check if user exists: %w", err)
+		}
+
+		if !exist {
+			return executor.Unrecoverable(fmt.Errorf("user %s does not exist", state.Username))
+		}
+
+		return c.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+			{Key: "dropUser", Value: state.Username},
+		}).Err()
+	})
 }
 
 func (r *ResourceUser) Update(ctx context.Context, plan types.User) error {
@@ -140,51 +90,34 @@ func (r *ResourceUser) Update(ctx context.Context, plan types.User) error {
 		return fmt.Errorf("user %s is a default user and cannot be updated", plan.Username)
 	}
 
-	err := retry.Do(
-		func() error {
-			c, err := r.connect(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to connect to MongoDB: %s", err)
-			}
-
-			defer func() {
-				disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-				_ = c.Disconnect(disconnectCtx)
-				cancel()
-			}()
-
-			exist, err := userExists(ctx, c, plan.Username)
-			if err != nil {
-				return fmt.Errorf("failed to check if user exists: %s", err)
-			}
-
-			if !exist {
-				return retry.Unrecoverable(fmt.Errorf("user %s does not exist", plan.Username))
-			}
-
-			roles := make([]bson.M, 0, len(plan.Roles))
-			for _, i := range plan.Roles {
-				roles = append(roles, bson.M{
-					"role": i.Role,
-					"db":   i.Database,
-				})
-			}
-
-			command := bson.D{
-				{"updateUser", plan.Username},
-				{"pwd", plan.Password},
-				{"roles", roles},
-			}
-
-			return c.Database(types.DefaultDatabase).RunCommand(ctx, command).Err()
-		},
-		retry.Attempts(r.RetryAttempts),
-		retry.DelayType(retry.BackOffDelay),
-		retry.Delay(r.RetryDelay),
-		retry.Context(ctx),
-	)
-
-	return err
+	op := executor.Operation{Name: "update user"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		exist, err := userExists(ctx, c, plan.Username)
+		if err != nil {
+			return fmt.Errorf("failed to check if user exists: %w", err)
+		}
+
+		if !exist {
+			return executor.Unrecoverable(fmt.Errorf("user %s does not exist", plan.Username))
+		}
+
+		roles := make([]bson.M, 0, len(plan.Roles))
+		for _, i := range plan.Roles {
+			roles = append(roles, bson.M{
+				"role": i.Role,
+				"db":   i.Database,
+			})
+		}
+
+		command := bson.D{
+			{Key: "updateUser", Value: plan.Username},
+			{Key: "pwd", Value: plan.Password},
+			{Key: "roles", Value: roles},
+		}
+
+		return c.Database(types.DefaultDatabase).RunCommand(ctx, command).Err()
+	})
 }
 
 func (r *ResourceUser) ImportState(ctx context.Context, username string) (types.User, error) {
@@ -194,74 +127,39 @@ func (r *ResourceUser) ImportState(ctx context.Context, username string) (types.
 		return types.User{}, fmt.Errorf("user %s is a default user and cannot be imported", username)
 	}
 
-	err := retry.Do(
-		func() error {
-			c, err := r.connect(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to connect to MongoDB: %s", err)
-			}
-
-			defer func() {
-				disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-				_ = c.Disconnect(disconnectCtx)
-				cancel()
-			}()
-
-			users, err := listUsers(ctx, c)
-			if err != nil {
-				return fmt.Errorf("failed to check if user exists: %s", err)
-			}
-
-			if !users.Exist(username) {
-				return retry.Unrecoverable(fmt.Errorf("user %s does not exist", username))
-			}
-
-			user := users.Get(username)
-
-			roles := make([]types.Role, 0, len(user.Roles))
-			for _, i := range user.Roles {
-				roles = append(roles, types.Role{
-					Role:     i.Role,
-					Database: i.Database,
-				})
-			}
-
-			u = types.User{
-				Username: user.Username,
-				Roles:    roles,
-			}
-
-			return nil
-		},
-		retry.Attempts(r.RetryAttempts),
-		retry.DelayType(retry.BackOffDelay),
-		retry.Delay(r.RetryDelay),
-		retry.Context(ctx),
-	)
+	op := executor.Operation{Name: "import user"}
 
-	if err != nil {
-		return types.User{}, err
-	}
+	err := r.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		users, err := listUsers(ctx, c)
+		if err != nil {
+			return fmt.Errorf("failed to check if user exists: %w", err)
+		}
 
-	return u, nil
-}
+		if !users.Exist(username) {
+			return executor.Unrecoverable(fmt.Errorf("user %s does not exist", username))
+		}
 
-func (r *ResourceUser) connect(ctx context.Context) (*mongo.Client, error) {
-	opts := options.Client().ApplyURI(r.Uri)
+		user := users.Get(username)
 
-	client, err := mongo.Connect(opts)
-	if err != nil {
-		return nil, err
-	}
+		roles := make([]types.Role, 0, len(user.Roles))
+		for _, i := range user.Roles {
+			roles = append(roles, types.Role{
+				Role:     i.Role,
+				Database: i.Database,
+			})
+		}
 
-	err = client.Ping(ctx, nil)
-	if err != nil {
-		disconnectCtx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
-		_ = client.Disconnect(disconnectCtx)
-		cancel()
+		u = types.User{
+			Username: user.Username,
+			Roles:    roles,
+		}
 
-		return nil, fmt.Errorf("failed to ping MongoDB: %s", err)
+		return nil
+	})
+
+	if err != nil {
+		return types.User{}, err
 	}
 
-	return client, nil
+	return u, nil
 }
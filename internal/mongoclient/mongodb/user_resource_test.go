@@ -0,0 +1,124 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func testUser() types.User {
+	return types.User{
+		Username: "app",
+		Password: "secret",
+		Roles:    []types.Role{{Role: "readWrite", Database: "app"}},
+	}
+}
+
+func TestResourceUserCreate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	r := &ResourceUser{Executor: newTestExecutor(srv)}
+
+	if err := r.Create(context.Background(), testUser()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmds := srv.Commands("createUser")
+	if len(cmds) != 1 {
+		t.Fatalf("expected 1 createUser, got %d", len(cmds))
+	}
+
+	if db := lookup(t, cmds[0], "$db").StringValue(); db != types.DefaultDatabase {
+		t.Fatalf("expected user created in %s, got %s", types.DefaultDatabase, db)
+	}
+
+	if role := lookup(t, cmds[0], "roles", "0", "role").StringValue(); role != "readWrite" {
+		t.Fatalf("expected role readWrite, got %s", role)
+	}
+}
+
+func TestResourceUserCreateRetry(t *testing.T) {
+	tests := []struct {
+		name      string
+		script    []mongotest.Handler
+		wantCalls int
+		wantErr   string
+	}{
+		{
+			name:      "retries network errors",
+			script:    []mongotest.Handler{mongotest.Disconnect, mongotest.Disconnect},
+			wantCalls: 3,
+		},
+		{
+			name:      "retries transient error codes",
+			script:    []mongotest.Handler{mongotest.Fail(91), mongotest.Fail(189)},
+			wantCalls: 3,
+		},
+		{
+			name:      "retries transient error labels",
+			script:    []mongotest.Handler{mongotest.Fail(1, "RetryableWriteError")},
+			wantCalls: 2,
+		},
+		{
+			name:      "gives up after all attempts",
+			script:    []mongotest.Handler{mongotest.Fail(91), mongotest.Fail(91), mongotest.Fail(91)},
+			wantCalls: 3,
+			wantErr:   "failed after 3 attempts",
+		},
+		{
+			name:      "does not retry permanent errors",
+			script:    []mongotest.Handler{mongotest.Fail(13)},
+			wantCalls: 1,
+			wantErr:   "non-retryable error Unauthorized (code 13)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.Script("createUser", tt.script...)
+
+			r := &ResourceUser{Executor: newTestExecutor(srv)}
+
+			err := r.Create(context.Background(), testUser())
+
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+
+			if calls := srv.Calls("createUser"); calls != tt.wantCalls {
+				t.Fatalf("expected %d createUser calls, got %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func TestResourceUserCreateExisting(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("usersInfo", mongotest.Reply(bson.E{Key: "users", Value: bson.A{
+		bson.D{{Key: "user", Value: "app"}, {Key: "roles", Value: bson.A{}}},
+	}}))
+
+	r := &ResourceUser{Executor: newTestExecutor(srv)}
+
+	err := r.Create(context.Background(), testUser())
+	if err == nil || !strings.Contains(err.Error(), "user app already exists") {
+		t.Fatalf("expected already exists error, got %v", err)
+	}
+
+	if calls := srv.Calls("usersInfo"); calls != 1 {
+		t.Fatalf("expected no retries, got %d usersInfo calls", calls)
+	}
+
+	if calls := srv.Calls("createUser"); calls != 0 {
+		t.Fatalf("expected no createUser, got %d", calls)
+	}
+}
@@ -0,0 +1,260 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// viewOptions are the options of a view reported by listCollections.
+type viewOptions struct {
+	ViewOn    string        `bson:"viewOn"`
+	Pipeline  bson.RawValue `bson:"pipeline"`
+	Collation *struct {
+		Locale string `bson:"locale"`
+	} `bson:"collation"`
+}
+
+func (r *ResourceView) Create(ctx context.Context, plan types.View) error {
+	pipeline, err := parseViewPipeline(plan.Pipeline)
+	if err != nil {
+		return executor.Unrecoverable(err)
+	}
+
+	cmd := bson.D{
+		{Key: "create", Value: plan.Name},
+		{Key: "viewOn", Value: plan.ViewOn},
+		{Key: "pipeline", Value: pipeline},
+	}
+
+	if plan.Collation != nil {
+		cmd = append(cmd, bson.E{Key: "collation", Value: bson.D{{Key: "locale", Value: *plan.Collation}}})
+	}
+
+	op := executor.Operation{Name: "create view"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := c.Database(plan.Database).RunCommand(ctx, cmd).Err()
+		if err != nil {
+			return fmt.Errorf("create view failed with error: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// Update changes the source and the pipeline of the view in place with collMod.
+func (r *ResourceView) Update(ctx context.Context, plan types.View) error {
+	pipeline, err := parseViewPipeline(plan.Pipeline)
+	if err != nil {
+		return executor.Unrecoverable(err)
+	}
+
+	op := executor.Operation{Name: "update view"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := c.Database(plan.Database).RunCommand(ctx, bson.D{
+			{Key: "collMod", Value: plan.Name},
+			{Key: "viewOn", Value: plan.ViewOn},
+			{Key: "pipeline", Value: pipeline},
+		}).Err()
+		if err != nil {
+			return fmt.Errorf("collMod failed with error: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *ResourceView) Delete(ctx context.Context, state types.View) error {
+	op := executor.Operation{Name: "delete view"}
+
+	return r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		return dropCollection(ctx, c, state.Database, state.Name)
+	})
+}
+
+// Read returns the definition of the view from listCollections. A pipeline equal to the state, e.g. with
+// numbers of another type or other formatting, keeps the state value. It returns false if the view does not exist.
+func (r *ResourceView) Read(ctx context.Context, state types.View) (types.View, bool, error) {
+	var current *types.View
+
+	op := executor.Operation{Name: "read view"}
+
+	err := r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		var err error
+
+		current, err = getView(ctx, c, state.Database, state.Name)
+
+		return err
+	})
+	if err != nil {
+		return state, false, err
+	}
+
+	if current == nil {
+		return state, false, nil
+	}
+
+	state.ViewOn = current.ViewOn
+	state.Collation = current.Collation
+
+	if !samePipeline(current.Pipeline, state.Pipeline) {
+		state.Pipeline = current.Pipeline
+	}
+
+	return state, true, nil
+}
+
+// ImportState imports the view by the <database>.<name> namespace.
+func (r *ResourceView) ImportState(ctx context.Context, namespace string) (types.View, error) {
+	var current *types.View
+
+	db, name, ok := splitNamespace(namespace)
+	if !ok {
+		return types.View{}, fmt.Errorf("invalid import ID %q, expected <database>.<view>", namespace)
+	}
+
+	op := executor.Operation{Name: "import view"}
+
+	err := r.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		var err error
+
+		current, err = getView(ctx, c, db, name)
+
+		return err
+	})
+	if err != nil {
+		return types.View{}, err
+	}
+
+	if current == nil {
+		return types.View{}, fmt.Errorf("view %s does not exist", namespace)
+	}
+
+	return *current, nil
+}
+
+// getView returns the view from listCollections, or nil if there is no collection or view with the name.
+func getView(ctx context.Context, client *mongo.Client, database, name string) (*types.View, error) {
+	var opts viewOptions
+
+	found, err := getCollectionOptions(ctx, client, database, name, collectionTypeView, &opts)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	pipeline, err := formatExtJSON(opts.Pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &types.View{Database: database, Name: name, ViewOn: opts.ViewOn, Pipeline: pipeline}
+
+	if opts.Collation != nil {
+		v.Collation = &opts.Collation.Locale
+	}
+
+	return v, nil
+}
+
+// parseViewPipeline parses the pipeline, an array of stages in Extended JSON.
+func parseViewPipeline(pipeline string) (bson.RawValue, error) {
+	v, err := parseExtJSON(pipeline)
+	if err != nil {
+		return v, fmt.Errorf("pipeline %w", err)
+	}
+
+	if v.Type != bson.TypeArray {
+		return v, fmt.Errorf("pipeline %s is not an array of stages", pipeline)
+	}
+
+	return v, nil
+}
+
+// samePipeline compares the pipelines as ordered documents, as the order of the fields matters in stages
+// such as $sort. Numbers of different types with the same value are equal, e.g. 10 and 10.0.
+func samePipeline(a, b string) bool {
+	stagesA, errA := decodeViewPipeline(a)
+	stagesB, errB := decodeViewPipeline(b)
+
+	if errA != nil || errB != nil {
+		return a == b
+	}
+
+	return sameValue(stagesA, stagesB)
+}
+
+// decodeViewPipeline decodes the pipeline into stages of ordered documents.
+func decodeViewPipeline(pipeline string) (bson.A, error) {
+	v, err := parseViewPipeline(pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	var stages bson.A
+
+	err = v.Unmarshal(&stages)
+
+	return stages, err
+}
+
+// sameValue compares decoded BSON values, the fields of documents in order and numbers by value.
+func sameValue(a, b interface{}) bool {
+	switch a := a.(type) {
+	case bson.D:
+		b, ok := b.(bson.D)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		for i := range a {
+			if a[i].Key != b[i].Key || !sameValue(a[i].Value, b[i].Value) {
+				return false
+			}
+		}
+
+		return true
+	case bson.A:
+		b, ok := b.(bson.A)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		for i := range a {
+			if !sameValue(a[i], b[i]) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if numberA, ok := numberValue(a); ok {
+		numberB, ok := numberValue(b)
+
+		return ok && numberA == numberB
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// numberValue returns the value of an integer or double.
+func numberValue(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,223 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// activeOrders is the listCollections entry of a view of the active orders.
+func activeOrders(limit interface{}) bson.D {
+	return bson.D{
+		{Key: "name", Value: "active_orders"},
+		{Key: "type", Value: "view"},
+		{Key: "options", Value: bson.D{
+			{Key: "viewOn", Value: "orders"},
+			{Key: "pipeline", Value: bson.A{
+				bson.D{{Key: "$match", Value: bson.D{{Key: "status", Value: "active"}}}},
+				bson.D{{Key: "$limit", Value: limit}},
+			}},
+			{Key: "collation", Value: bson.D{{Key: "locale", Value: "fr"}, {Key: "strength", Value: 3}}},
+		}},
+		{Key: "info", Value: bson.D{{Key: "readOnly", Value: true}}},
+	}
+}
+
+func TestResourceViewCreate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceView{Executor: newTestExecutor(srv)}
+
+	collation := "fr"
+	plan := types.View{
+		Database:  "app",
+		Name:      "active_orders",
+		ViewOn:    "orders",
+		Pipeline:  `[{"$match":{"status":"active"}},{"$limit":10}]`,
+		Collation: &collation,
+	}
+
+	if err := r.Create(context.Background(), plan); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmd := srv.Commands("create")[0]
+
+	if db := lookup(t, cmd, "$db").StringValue(); db != "app" {
+		t.Fatalf("expected create on app, got %s", db)
+	}
+
+	if v := lookup(t, cmd, "viewOn").StringValue(); v != "orders" {
+		t.Fatalf("expected viewOn orders, got %s", v)
+	}
+
+	if v := lookup(t, cmd, "pipeline", "1", "$limit"); v.Type != bson.TypeInt32 || v.Int32() != 10 {
+		t.Fatalf("expected int32 limit 10, got %s", v)
+	}
+
+	if v := lookup(t, cmd, "collation", "locale").StringValue(); v != "fr" {
+		t.Fatalf("expected collation fr, got %s", v)
+	}
+}
+
+func TestResourceViewCreateInvalidPipeline(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceView{Executor: newTestExecutor(srv)}
+
+	err := r.Create(context.Background(), types.View{Database: "app", Name: "v", ViewOn: "orders", Pipeline: `{"$match":{}}`})
+	if err == nil || !strings.Contains(err.Error(), "not an array of stages") {
+		t.Fatalf("expected pipeline error, got %v", err)
+	}
+
+	if srv.Calls("create") != 0 {
+		t.Fatal("expected no create")
+	}
+}
+
+func TestResourceViewUpdate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceView{Executor: newTestExecutor(srv)}
+
+	plan := types.View{Database: "app", Name: "active_orders", ViewOn: "archived_orders", Pipeline: `[]`}
+
+	if err := r.Update(context.Background(), plan); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmd := srv.Commands("collMod")[0]
+
+	if v := lookup(t, cmd, "collMod").StringValue(); v != "active_orders" {
+		t.Fatalf("expected collMod of active_orders, got %s", v)
+	}
+
+	if v := lookup(t, cmd, "viewOn").StringValue(); v != "archived_orders" {
+		t.Fatalf("expected viewOn archived_orders, got %s", v)
+	}
+
+	if stages, err := lookup(t, cmd, "pipeline").Array().Values(); err != nil || len(stages) != 0 {
+		t.Fatalf("expected an empty pipeline, got %v", stages)
+	}
+}
+
+func TestResourceViewDelete(t *testing.T) {
+	srv := mongotest.NewServer(t)
+
+	r := &ResourceView{Executor: newTestExecutor(srv)}
+
+	if err := r.Delete(context.Background(), types.View{Database: "app", Name: "active_orders"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if v := lookup(t, srv.Commands("drop")[0], "drop").StringValue(); v != "active_orders" {
+		t.Fatalf("expected drop of active_orders, got %s", v)
+	}
+}
+
+func TestResourceViewRead(t *testing.T) {
+	tests := []struct {
+		name         string
+		collections  []bson.D
+		state        string
+		wantExist    bool
+		wantPipeline string
+		wantErr      string
+	}{
+		{
+			name:         "same pipeline",
+			collections:  []bson.D{activeOrders(int32(10))},
+			state:        `[{"$match": {"status": "active"}}, {"$limit": 10.0}]`,
+			wantExist:    true,
+			wantPipeline: `[{"$match": {"status": "active"}}, {"$limit": 10.0}]`,
+		},
+		{
+			name:         "pipeline changed outside terraform",
+			collections:  []bson.D{activeOrders(int32(20))},
+			state:        `[{"$match":{"status":"active"}},{"$limit":10}]`,
+			wantExist:    true,
+			wantPipeline: `[{"$match":{"status":"active"}},{"$limit":20}]`,
+		},
+		{
+			name: "stage fields in another order",
+			collections: []bson.D{{
+				{Key: "name", Value: "active_orders"},
+				{Key: "type", Value: "view"},
+				{Key: "options", Value: bson.D{
+					{Key: "viewOn", Value: "orders"},
+					{Key: "pipeline", Value: bson.A{bson.D{{Key: "$sort", Value: bson.D{{Key: "created_at", Value: int32(-1)}, {Key: "tenant_id", Value: int32(1)}}}}}},
+					{Key: "collation", Value: bson.D{{Key: "locale", Value: "fr"}}},
+				}},
+			}},
+			state:        `[{"$sort": {"tenant_id": 1, "created_at": -1}}]`,
+			wantExist:    true,
+			wantPipeline: `[{"$sort":{"created_at":-1,"tenant_id":1}}]`,
+		},
+		{
+			name:  "view dropped",
+			state: `[]`,
+		},
+		{
+			name:        "collection",
+			collections: []bson.D{{{Key: "name", Value: "active_orders"}, {Key: "type", Value: "collection"}, {Key: "options", Value: bson.D{}}}},
+			state:       `[]`,
+			wantErr:     "is a collection, not a view",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.Handle("listCollections", mongotest.Cursor(tt.collections...))
+
+			r := &ResourceView{Executor: newTestExecutor(srv)}
+
+			got, exist, err := r.Read(context.Background(), types.View{Database: "app", Name: "active_orders", ViewOn: "orders", Pipeline: tt.state})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if exist != tt.wantExist {
+				t.Fatalf("expected exist %t, got %t", tt.wantExist, exist)
+			}
+
+			if exist && (got.Pipeline != tt.wantPipeline || got.Collation == nil || *got.Collation != "fr") {
+				t.Fatalf("expected pipeline %s with collation fr, got %+v", tt.wantPipeline, got)
+			}
+		})
+	}
+}
+
+func TestResourceViewImportState(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.Handle("listCollections", mongotest.Cursor(activeOrders(int32(10))))
+
+	r := &ResourceView{Executor: newTestExecutor(srv)}
+
+	got, err := r.ImportState(context.Background(), "app.active_orders")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `[{"$match":{"status":"active"}},{"$limit":10}]`
+	if got.Database != "app" || got.Name != "active_orders" || got.ViewOn != "orders" || got.Pipeline != want {
+		t.Fatalf("expected view on orders with pipeline %s, got %+v", want, got)
+	}
+
+	if _, err := r.ImportState(context.Background(), "active_orders"); err == nil {
+		t.Fatal("expected invalid import ID error")
+	}
+}
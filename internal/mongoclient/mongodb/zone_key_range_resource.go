@@ -0,0 +1,200 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Create validates the bounds against the shard key of the collection and assigns the range to the zone.
+func (r *ResourceZoneKeyRange) Create(ctx context.Context, plan types.ZoneKeyRange) error {
+	op := executor.Operation{Name: "create zone key range"}
+
+	return r.Executor.WithRetry(plan.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		lower, upper, err := zoneRangeBounds(ctx, c, plan)
+		if err != nil {
+			return err
+		}
+
+		ranges, err := listZoneRanges(ctx, c, plan.Namespace())
+		if err != nil {
+			return fmt.Errorf("failed to list zone key ranges: %w", err)
+		}
+
+		if existing := findZoneRange(ranges, lower); existing != nil {
+			return executor.Unrecoverable(fmt.Errorf("zone key range %s of %s already exists in zone %s", plan.Min, plan.Namespace(), existing.Tag))
+		}
+
+		return c.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+			{Key: "updateZoneKeyRange", Value: plan.Namespace()},
+			{Key: "min", Value: lower},
+			{Key: "max", Value: upper},
+			{Key: "zone", Value: plan.Zone},
+		}).Err()
+	})
+}
+
+// Delete removes the range by updating it with a null zone.
+func (r *ResourceZoneKeyRange) Delete(ctx context.Context, state types.ZoneKeyRange) error {
+	op := executor.Operation{Name: "delete zone key range"}
+
+	return r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		lower, upper, err := zoneRangeBounds(ctx, c, state)
+		if err != nil {
+			return err
+		}
+
+		ranges, err := listZoneRanges(ctx, c, state.Namespace())
+		if err != nil {
+			return fmt.Errorf("failed to list zone key ranges: %w", err)
+		}
+
+		if findZoneRange(ranges, lower) == nil {
+			return executor.Unrecoverable(fmt.Errorf("zone key range %s of %s does not exist", state.Min, state.Namespace()))
+		}
+
+		return c.Database(types.DefaultDatabase).RunCommand(ctx, bson.D{
+			{Key: "updateZoneKeyRange", Value: state.Namespace()},
+			{Key: "min", Value: lower},
+			{Key: "max", Value: upper},
+			{Key: "zone", Value: nil},
+		}).Err()
+	})
+}
+
+// Read looks up the range by its lower bound in config.tags and refreshes its zone and upper bound.
+// The bounds from the state are kept while they match, so their formatting is preserved.
+func (r *ResourceZoneKeyRange) Read(ctx context.Context, state types.ZoneKeyRange) (types.ZoneKeyRange, bool, error) {
+	var current *zoneRange
+	var upper bson.D
+
+	op := executor.Operation{Name: "read zone key range"}
+
+	err := r.Executor.WithRetry(state.Retry).Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		collection, err := getShardedCollection(ctx, c, state.Namespace())
+		if err != nil || collection == nil {
+			return err
+		}
+
+		lower, err := parseZoneBound(state.Min, collection.Key)
+		if err != nil {
+			return executor.Unrecoverable(fmt.Errorf("invalid min of zone key range: %w", err))
+		}
+
+		upper, _ = parseZoneBound(state.Max, collection.Key)
+
+		ranges, err := listZoneRanges(ctx, c, state.Namespace())
+		if err != nil {
+			return err
+		}
+
+		current = findZoneRange(ranges, lower)
+
+		return nil
+	})
+
+	if err != nil || current == nil {
+		return state, false, err
+	}
+
+	state.Zone = current.Tag
+
+	if !sameBound(current.Max, upper) {
+		state.Max, err = formatZoneBound(current.Max)
+		if err != nil {
+			return state, false, fmt.Errorf("failed to format max of zone key range: %w", err)
+		}
+	}
+
+	return state, true, nil
+}
+
+// ImportState imports the range by the <database>.<collection>/<min> ID, where min is the lower bound in Extended JSON.
+func (r *ResourceZoneKeyRange) ImportState(ctx context.Context, id string) (types.ZoneKeyRange, error) {
+	var s types.ZoneKeyRange
+
+	op := executor.Operation{Name: "import zone key range"}
+
+	namespace, lowerBound, _ := strings.Cut(id, "/")
+
+	db, collection, ok := splitNamespace(namespace)
+	if !ok || lowerBound == "" {
+		return s, fmt.Errorf("invalid import ID %q, expected <database>.<collection>/<min>", id)
+	}
+
+	err := r.Executor.Do(ctx, op, func(ctx context.Context, c *mongo.Client) error {
+		err := requireMongos(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		sharded, err := getShardedCollection(ctx, c, namespace)
+		if err != nil {
+			return fmt.Errorf("failed to get sharding metadata: %w", err)
+		}
+
+		if sharded == nil {
+			return executor.Unrecoverable(fmt.Errorf("collection %s is not sharded", namespace))
+		}
+
+		lower, err := parseZoneBound(lowerBound, sharded.Key)
+		if err != nil {
+			return executor.Unrecoverable(fmt.Errorf("invalid min of zone key range: %w", err))
+		}
+
+		ranges, err := listZoneRanges(ctx, c, namespace)
+		if err != nil {
+			return fmt.Errorf("failed to list zone key ranges: %w", err)
+		}
+
+		current := findZoneRange(ranges, lower)
+		if current == nil {
+			return executor.Unrecoverable(fmt.Errorf("zone key range %s of %s does not exist", lowerBound, namespace))
+		}
+
+		s = types.ZoneKeyRange{
+			Database:   db,
+			Collection: collection,
+			Zone:       current.Tag,
+		}
+
+		s.Min, err = formatZoneBound(current.Min)
+		if err != nil {
+			return executor.Unrecoverable(fmt.Errorf("failed to format min of zone key range: %w", err))
+		}
+
+		s.Max, err = formatZoneBound(current.Max)
+		if err != nil {
+			return executor.Unrecoverable(fmt.Errorf("failed to format max of zone key range: %w", err))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return types.ZoneKeyRange{}, err
+	}
+
+	return s, nil
+}
@@ -0,0 +1,257 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/mongotest"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// zoneConfig returns a find handler with app.orders sharded by tenant_id and order_id,
+// shard01 in the EU zone and the ranges in config.tags.
+func zoneConfig(ranges ...bson.D) mongotest.Handler {
+	return mongotest.Collections(map[string][]bson.D{
+		"config.collections": {configCollection(bson.D{{Key: "tenant_id", Value: 1}, {Key: "order_id", Value: 1}}, false)},
+		"config.shards":      {{{Key: "_id", Value: "shard01"}, {Key: "host", Value: testShard().Host}, {Key: "tags", Value: bson.A{"EU"}}}},
+		"config.tags":        ranges,
+	})
+}
+
+// configTag returns a config.tags document of app.orders.
+func configTag(zone string, lower, upper bson.D) bson.D {
+	return bson.D{
+		{Key: "ns", Value: "app.orders"},
+		{Key: "min", Value: lower},
+		{Key: "max", Value: upper},
+		{Key: "tag", Value: zone},
+	}
+}
+
+func testZoneKeyRange() types.ZoneKeyRange {
+	return types.ZoneKeyRange{
+		Database:   "app",
+		Collection: "orders",
+		Zone:       "EU",
+		Min:        `{"order_id":{"$minKey":1},"tenant_id":"eu"}`,
+		Max:        `{"order_id":{"$maxKey":1},"tenant_id":"eu"}`,
+	}
+}
+
+func TestResourceShardZoneCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		zone    string
+		find    mongotest.Handler
+		wantErr string
+	}{
+		{name: "adds shard to zone", zone: "US", find: zoneConfig()},
+		{name: "shard already in zone", zone: "EU", find: zoneConfig(), wantErr: "already in zone"},
+		{name: "shard does not exist", zone: "US", find: mongotest.Cursor(), wantErr: "does not exist"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.SetMongos(true)
+			srv.Handle("find", tt.find)
+
+			r := &ResourceShardZone{Executor: newTestExecutor(srv)}
+
+			err := r.Create(context.Background(), types.ShardZone{Shard: "shard01", Zone: tt.zone})
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			cmd := srv.Commands("addShardToZone")[0]
+			if zone := lookup(t, cmd, "zone").StringValue(); zone != "US" {
+				t.Fatalf("expected zone US, got %s", zone)
+			}
+		})
+	}
+}
+
+func TestResourceShardZoneExists(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+	srv.Handle("find", zoneConfig())
+
+	r := &ResourceShardZone{Executor: newTestExecutor(srv)}
+
+	for zone, want := range map[string]bool{"EU": true, "US": false} {
+		exist, err := r.Exists(context.Background(), types.ShardZone{Shard: "shard01", Zone: zone})
+		if err != nil || exist != want {
+			t.Fatalf("expected zone %s to exist: %t, got %t, error: %v", zone, want, exist, err)
+		}
+	}
+}
+
+func TestResourceZoneKeyRangeCreate(t *testing.T) {
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+	srv.Handle("find", zoneConfig())
+
+	r := &ResourceZoneKeyRange{Executor: newTestExecutor(srv)}
+
+	if err := r.Create(context.Background(), testZoneKeyRange()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cmd := srv.Commands("updateZoneKeyRange")[0]
+
+	elems, err := lookup(t, cmd, "min").Document().Elements()
+	if err != nil || len(elems) != 2 || elems[0].Key() != "tenant_id" || elems[1].Key() != "order_id" {
+		t.Fatalf("expected min ordered as the shard key, got %s", lookup(t, cmd, "min"))
+	}
+
+	if v := lookup(t, cmd, "max", "order_id").Type; v != bson.TypeMaxKey {
+		t.Fatalf("expected max key, got %s", v)
+	}
+
+	if zone := lookup(t, cmd, "zone").StringValue(); zone != "EU" {
+		t.Fatalf("expected zone EU, got %s", zone)
+	}
+}
+
+func TestResourceZoneKeyRangeCreateInvalidBounds(t *testing.T) {
+	tests := map[string]string{
+		"missing field": `{"tenant_id":"eu"}`,
+		"extra field":   `{"tenant_id":"eu","order_id":1,"region":"eu"}`,
+		"not json":      `tenant_id=eu`,
+	}
+
+	for name, bound := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.SetMongos(true)
+			srv.Handle("find", zoneConfig())
+
+			r := &ResourceZoneKeyRange{Executor: newTestExecutor(srv)}
+
+			zoneRange := testZoneKeyRange()
+			zoneRange.Min = bound
+
+			err := r.Create(context.Background(), zoneRange)
+			if err == nil || !strings.Contains(err.Error(), "invalid min") {
+				t.Fatalf("expected invalid min error, got %v", err)
+			}
+
+			if srv.Calls("updateZoneKeyRange") != 0 {
+				t.Fatal("updateZoneKeyRange must not be called")
+			}
+		})
+	}
+}
+
+func TestResourceZoneKeyRangeRead(t *testing.T) {
+	lower := bson.D{{Key: "tenant_id", Value: "eu"}, {Key: "order_id", Value: bson.MinKey{}}}
+	upper := bson.D{{Key: "tenant_id", Value: "eu"}, {Key: "order_id", Value: bson.MaxKey{}}}
+
+	tests := []struct {
+		name      string
+		ranges    []bson.D
+		wantExist bool
+		wantZone  string
+		wantMax   string
+	}{
+		{
+			name:      "range unchanged",
+			ranges:    []bson.D{configTag("EU", lower, upper)},
+			wantExist: true,
+			wantZone:  "EU",
+			wantMax:   testZoneKeyRange().Max,
+		},
+		{
+			name:      "range moved to another zone with another upper bound",
+			ranges:    []bson.D{configTag("US", lower, bson.D{{Key: "tenant_id", Value: "us"}, {Key: "order_id", Value: bson.MinKey{}}})},
+			wantExist: true,
+			wantZone:  "US",
+			wantMax:   `{"tenant_id":"us","order_id":{"$minKey":1}}`,
+		},
+		{
+			name: "range removed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := mongotest.NewServer(t)
+			srv.SetMongos(true)
+			srv.Handle("find", zoneConfig(tt.ranges...))
+
+			r := &ResourceZoneKeyRange{Executor: newTestExecutor(srv)}
+
+			got, exist, err := r.Read(context.Background(), testZoneKeyRange())
+			if err != nil || exist != tt.wantExist {
+				t.Fatalf("expected range to exist: %t, got %t, error: %v", tt.wantExist, exist, err)
+			}
+
+			if !exist {
+				return
+			}
+
+			if got.Zone != tt.wantZone || got.Max != tt.wantMax {
+				t.Fatalf("expected zone %s and max %s, got %s and %s", tt.wantZone, tt.wantMax, got.Zone, got.Max)
+			}
+		})
+	}
+}
+
+func TestResourceZoneKeyRangeDelete(t *testing.T) {
+	lower := bson.D{{Key: "tenant_id", Value: "eu"}, {Key: "order_id", Value: bson.MinKey{}}}
+	upper := bson.D{{Key: "tenant_id", Value: "eu"}, {Key: "order_id", Value: bson.MaxKey{}}}
+
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+	srv.Handle("find", zoneConfig(configTag("EU", lower, upper)))
+
+	r := &ResourceZoneKeyRange{Executor: newTestExecutor(srv)}
+
+	if err := r.Delete(context.Background(), testZoneKeyRange()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if zone := lookup(t, srv.Commands("updateZoneKeyRange")[0], "zone").Type; zone != bson.TypeNull {
+		t.Fatalf("expected null zone, got %s", zone)
+	}
+}
+
+func TestResourceZoneKeyRangeImportState(t *testing.T) {
+	lower := bson.D{{Key: "tenant_id", Value: "eu"}, {Key: "order_id", Value: bson.MinKey{}}}
+	upper := bson.D{{Key: "tenant_id", Value: "eu"}, {Key: "order_id", Value: bson.MaxKey{}}}
+
+	srv := mongotest.NewServer(t)
+	srv.SetMongos(true)
+	srv.Handle("find", zoneConfig(configTag("EU", lower, upper)))
+
+	r := &ResourceZoneKeyRange{Executor: newTestExecutor(srv)}
+
+	got, err := r.ImportState(context.Background(), `app.orders/{"order_id":{"$minKey":1},"tenant_id":"eu"}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := types.ZoneKeyRange{
+		Database:   "app",
+		Collection: "orders",
+		Zone:       "EU",
+		Min:        `{"tenant_id":"eu","order_id":{"$minKey":1}}`,
+		Max:        `{"tenant_id":"eu","order_id":{"$maxKey":1}}`,
+	}
+
+	if got.Namespace() != want.Namespace() || got.Zone != want.Zone || got.Min != want.Min || got.Max != want.Max {
+		t.Fatalf("expected %+v, got %+v", want, got)
+	}
+}
@@ -0,0 +1,252 @@
+package mongotest
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Version is the MongoDB version reported by buildInfo.
+const Version = "6.0.5"
+
+// maxWireVersion is the wire version of MongoDB 6.0.
+const maxWireVersion = 17
+
+var connectionID atomic.Int32
+
+// Disconnect closes the connection instead of answering the command.
+var Disconnect Handler = func(bson.Raw) bson.D {
+	return nil
+}
+
+// Reply returns a handler answering with ok: 1 and the given fields.
+func Reply(fields ...bson.E) Handler {
+	return func(bson.Raw) bson.D {
+		return append(append(bson.D{}, fields...), bson.E{Key: "ok", Value: 1})
+	}
+}
+
+// Fail returns a handler answering with a command error with the code and error labels.
+func Fail(code int32, labels ...string) Handler {
+	return func(cmd bson.Raw) bson.D {
+		reply := bson.D{
+			{Key: "ok", Value: 0},
+			{Key: "errmsg", Value: fmt.Sprintf("command %s failed by the stub server", commandName(cmd))},
+			{Key: "code", Value: code},
+		}
+
+		if len(labels) > 0 {
+			reply = append(reply, bson.E{Key: "errorLabels", Value: labels})
+		}
+
+		return reply
+	}
+}
+
+// Cursor returns a handler answering find, aggregate, listCollections or listIndexes with the documents in a single batch.
+// The documents are filtered by the top-level equality conditions of a find filter, other conditions
+// are ignored.
+func Cursor(docs ...bson.D) Handler {
+	return func(cmd bson.Raw) bson.D {
+		filter, _ := cmd.Lookup("filter").DocumentOK()
+
+		batch := bson.A{}
+		for _, d := range docs {
+			if matches(d, filter) {
+				batch = append(batch, d)
+			}
+		}
+
+		return bson.D{
+			{Key: "cursor", Value: bson.D{
+				{Key: "id", Value: int64(0)},
+				{Key: "ns", Value: namespace(cmd)},
+				{Key: "firstBatch", Value: batch},
+			}},
+			{Key: "ok", Value: 1},
+		}
+	}
+}
+
+// matches checks the equality conditions of the filter. Operators, e.g. $in, match any document.
+func matches(doc bson.D, filter bson.Raw) bool {
+	elems, err := filter.Elements()
+	if err != nil {
+		return true
+	}
+
+	raw, err := bson.Marshal(doc)
+	if err != nil {
+		return false
+	}
+
+	for _, e := range elems {
+		if strings.HasPrefix(e.Key(), "$") || isOperator(e.Value()) {
+			continue
+		}
+
+		v, err := bson.Raw(raw).LookupErr(strings.Split(e.Key(), ".")...)
+		if err != nil || !v.Equal(e.Value()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isOperator checks if the condition is an operator document, e.g. {$exists: true}.
+func isOperator(v bson.RawValue) bool {
+	d, ok := v.DocumentOK()
+	if !ok {
+		return false
+	}
+
+	first, err := d.IndexErr(0)
+
+	return err == nil && strings.HasPrefix(first.Key(), "$")
+}
+
+// Collections returns a handler answering find, aggregate or listIndexes with the documents of the collection of the command,
+// given by namespace. Collections without documents are empty.
+func Collections(docs map[string][]bson.D) Handler {
+	return func(cmd bson.Raw) bson.D {
+		return Cursor(docs[namespace(cmd)]...)(cmd)
+	}
+}
+
+// namespace returns the <database>.<collection> namespace of a find, aggregate or listIndexes command.
+func namespace(cmd bson.Raw) string {
+	db, _ := cmd.Lookup("$db").StringValueOK()
+
+	collection, ok := cmd.Index(0).Value().StringValueOK()
+	if !ok {
+		collection = "$cmd.aggregate"
+	}
+
+	return db + "." + collection
+}
+
+// defaultHandlers answers the commands the provider issues as an empty MongoDB 6 would.
+// The replica set commands fail with NotYetInitialized, removeShard with ShardNotFound, getParameter
+// with InvalidOptions for any parameter but featureCompatibilityVersion and getClusterParameter
+// with NoSuchKey, as for an unknown parameter.
+func (s *Server) defaultHandlers() map[string]Handler {
+	return map[string]Handler{
+		"hello":       s.hello,
+		"ping":        Reply(),
+		"endSessions": Reply(),
+		"buildInfo": Reply(
+			bson.E{Key: "version", Value: Version},
+			bson.E{Key: "versionArray", Value: bson.A{6, 0, 5, 0}},
+			bson.E{Key: "maxBsonObjectSize", Value: 16 * 1024 * 1024},
+		),
+		"listDatabases": Reply(
+			bson.E{Key: "databases", Value: bson.A{}},
+			bson.E{Key: "totalSize", Value: 0},
+		),
+		"insert":                   Reply(bson.E{Key: "n", Value: 1}),
+		"dropDatabase":             Reply(),
+		"dbStats":                  Reply(bson.E{Key: "collections", Value: 0}, bson.E{Key: "objects", Value: 0}),
+		"count":                    Reply(bson.E{Key: "n", Value: 0}),
+		"usersInfo":                Reply(bson.E{Key: "users", Value: bson.A{}}),
+		"createUser":               Reply(),
+		"updateUser":               Reply(),
+		"dropUser":                 Reply(),
+		"replSetGetConfig":         Fail(94),
+		"replSetGetStatus":         Fail(94),
+		"replSetInitiate":          Reply(),
+		"replSetReconfig":          Reply(),
+		"listShards":               Reply(bson.E{Key: "shards", Value: bson.A{}}),
+		"addShard":                 Reply(),
+		"removeShard":              Fail(70),
+		"find":                     Cursor(),
+		"listCollections":          Cursor(),
+		"listIndexes":              Cursor(),
+		"create":                   Reply(),
+		"collMod":                  Reply(),
+		"drop":                     Reply(),
+		"aggregate":                Cursor(),
+		"enableSharding":           Reply(),
+		"shardCollection":          Reply(),
+		"refineCollectionShardKey": Reply(),
+		"reshardCollection":        Reply(),
+		"addShardToZone":           Reply(),
+		"removeShardFromZone":      Reply(),
+		"updateZoneKeyRange":       Reply(),
+		"balancerStatus":           Reply(bson.E{Key: "mode", Value: "full"}, bson.E{Key: "inBalancerRound", Value: false}),
+		"balancerStart":            Reply(),
+		"balancerStop":             Reply(),
+		"update":                   Reply(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+		"delete":                   Reply(bson.E{Key: "n", Value: 1}),
+		"getDefaultRWConcern": Reply(
+			bson.E{Key: "defaultWriteConcern", Value: bson.D{{Key: "w", Value: "majority"}, {Key: "wtimeout", Value: 0}}},
+			bson.E{Key: "defaultWriteConcernSource", Value: "implicit"},
+			bson.E{Key: "defaultReadConcern", Value: bson.D{{Key: "level", Value: "local"}}},
+			bson.E{Key: "defaultReadConcernSource", Value: "implicit"},
+		),
+		"setDefaultRWConcern": Reply(),
+		"getParameter":        getParameter,
+		"setParameter":        Reply(),
+		"getClusterParameter": Fail(4),
+		"setClusterParameter": Reply(),
+
+		"setFeatureCompatibilityVersion": Reply(),
+		"profile": Reply(
+			bson.E{Key: "was", Value: 0},
+			bson.E{Key: "slowms", Value: 100},
+			bson.E{Key: "sampleRate", Value: 1.0},
+		),
+	}
+}
+
+// getParameter reports the feature compatibility version of MongoDB 6.
+func getParameter(cmd bson.Raw) bson.D {
+	if _, err := cmd.LookupErr("featureCompatibilityVersion"); err != nil {
+		return Fail(72)(cmd)
+	}
+
+	return Reply(bson.E{Key: "featureCompatibilityVersion", Value: bson.D{{Key: "version", Value: "6.0"}}})(cmd)
+}
+
+// hello reports a writable standalone server, the primary of the replica set or a mongos.
+func (s *Server) hello(bson.Raw) bson.D {
+	s.mu.Lock()
+	replicaSet := s.replicaSet
+	mongos := s.mongos
+	s.mu.Unlock()
+
+	reply := bson.D{
+		{Key: "helloOk", Value: true},
+		{Key: "isWritablePrimary", Value: true},
+		{Key: "ismaster", Value: true},
+		{Key: "maxBsonObjectSize", Value: 16 * 1024 * 1024},
+		{Key: "maxMessageSizeBytes", Value: 48000000},
+		{Key: "maxWriteBatchSize", Value: 100000},
+		{Key: "localTime", Value: bson.NewDateTimeFromTime(time.Now())},
+		{Key: "logicalSessionTimeoutMinutes", Value: 30},
+		{Key: "connectionId", Value: connectionID.Add(1)},
+		{Key: "minWireVersion", Value: 0},
+		{Key: "maxWireVersion", Value: maxWireVersion},
+		{Key: "readOnly", Value: false},
+	}
+
+	if mongos {
+		reply = append(reply, bson.E{Key: "msg", Value: "isdbgrid"})
+	}
+
+	if replicaSet != "" {
+		reply = append(reply,
+			bson.E{Key: "setName", Value: replicaSet},
+			bson.E{Key: "setVersion", Value: 1},
+			bson.E{Key: "hosts", Value: bson.A{s.Addr}},
+			bson.E{Key: "primary", Value: s.Addr},
+			bson.E{Key: "me", Value: s.Addr},
+			bson.E{Key: "secondary", Value: false},
+		)
+	}
+
+	return append(reply, bson.E{Key: "ok", Value: 1})
+}
@@ -0,0 +1,429 @@
+// Package mongotest provides an in-process MongoDB wire-protocol server for hermetic tests.
+//
+// The server speaks OP_MSG and the legacy OP_QUERY handshake, which is enough for the driver
+// to connect, run commands and monitor the server. It knows the commands the provider issues
+// and answers them with defaults, which tests replace with Handle or override for the next calls
+// with Script to inject failures:
+//
+//	srv := mongotest.NewServer(t)
+//	srv.Script("usersInfo", mongotest.Fail(91), mongotest.Disconnect)
+//	srv.Handle("usersInfo", mongotest.Reply(bson.E{Key: "users", Value: bson.A{}}))
+package mongotest
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/wiremessage"
+)
+
+// Handler returns the reply to a command. A nil reply closes the connection without answering,
+// which the driver sees as a network error.
+type Handler func(cmd bson.Raw) bson.D
+
+// Server is a MongoDB stub listening on a localhost port.
+type Server struct {
+	// Addr is host:port the server listens on.
+	Addr string
+
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mu         sync.Mutex
+	handlers   map[string]Handler
+	scripts    map[string][]Handler
+	commands   map[string][]bson.Raw
+	conns      map[net.Conn]struct{}
+	replicaSet string
+	mongos     bool
+	closed     bool
+}
+
+// NewServer starts a server with the default handlers. It is closed when the test finishes.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start MongoDB stub server: %s", err)
+	}
+
+	s := &Server{
+		Addr:     l.Addr().String(),
+		listener: l,
+		scripts:  map[string][]Handler{},
+		commands: map[string][]bson.Raw{},
+		conns:    map[net.Conn]struct{}{},
+	}
+	s.handlers = s.defaultHandlers()
+
+	s.wg.Add(1)
+	go s.serve()
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// URI returns the connection string of the server. It includes the replicaSet option
+// when the server is a replica set member.
+func (s *Server) URI() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.replicaSet != "" {
+		return fmt.Sprintf("mongodb://%s/?replicaSet=%s", s.Addr, s.replicaSet)
+	}
+
+	return fmt.Sprintf("mongodb://%s/", s.Addr)
+}
+
+// SetReplicaSet makes hello report the server as the primary of the replica set name.
+// An empty name makes it a standalone server again.
+func (s *Server) SetReplicaSet(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.replicaSet = name
+}
+
+// SetMongos makes hello report the server as a mongos router of a sharded cluster.
+func (s *Server) SetMongos(mongos bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.mongos = mongos
+}
+
+// Handle replaces the handler of the command.
+func (s *Server) Handle(name string, h Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[name] = h
+}
+
+// Script queues handlers for the next calls of the command, one handler per call.
+// When the queue is empty the command is answered by its handler again.
+func (s *Server) Script(name string, hs ...Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.scripts[name] = append(s.scripts[name], hs...)
+}
+
+// Calls returns how many times the command was received.
+func (s *Server) Calls(name string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.commands[name])
+}
+
+// Commands returns the received command documents in order.
+func (s *Server) Commands(name string) []bson.Raw {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]bson.Raw(nil), s.commands[name]...)
+}
+
+// Close stops the server and closes every connection.
+func (s *Server) Close() {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+
+	s.closed = true
+	for c := range s.conns {
+		_ = c.Close()
+	}
+	s.mu.Unlock()
+
+	_ = s.listener.Close()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+
+	for {
+		c, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			_ = c.Close()
+			return
+		}
+		s.conns[c] = struct{}{}
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go s.serveConn(c)
+	}
+}
+
+func (s *Server) serveConn(c net.Conn) {
+	defer s.wg.Done()
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, c)
+		s.mu.Unlock()
+
+		_ = c.Close()
+	}()
+
+	for {
+		wm, err := readMessage(c)
+		if err != nil {
+			return
+		}
+
+		reply, err := s.handleMessage(wm)
+		if err != nil || reply == nil {
+			return
+		}
+
+		if _, err := c.Write(reply); err != nil {
+			return
+		}
+	}
+}
+
+// handleMessage returns the reply to the wire message. A nil reply closes the connection.
+func (s *Server) handleMessage(wm []byte) ([]byte, error) {
+	_, requestID, _, opcode, rem, ok := wiremessage.ReadHeader(wm)
+	if !ok {
+		return nil, errors.New("malformed message header")
+	}
+
+	switch opcode {
+	case wiremessage.OpMsg:
+		cmd, err := readMsgBody(rem)
+		if err != nil {
+			return nil, err
+		}
+
+		reply := s.dispatch(cmd)
+		if reply == nil {
+			return nil, nil
+		}
+
+		return appendMsgReply(requestID, reply)
+	case wiremessage.OpQuery:
+		// The driver sends the first hello of every connection as a legacy OP_QUERY
+		cmd, err := readQueryBody(rem)
+		if err != nil {
+			return nil, err
+		}
+
+		reply := s.dispatch(cmd)
+		if reply == nil {
+			return nil, nil
+		}
+
+		return appendQueryReply(requestID, reply)
+	default:
+		return nil, fmt.Errorf("unsupported opcode %s", opcode)
+	}
+}
+
+// dispatch records the command and returns the reply of the next scripted handler or the command handler.
+func (s *Server) dispatch(cmd bson.Raw) bson.D {
+	name := commandName(cmd)
+
+	s.mu.Lock()
+	s.commands[name] = append(s.commands[name], cmd)
+
+	h, ok := s.handlers[name]
+	if script := s.scripts[name]; len(script) > 0 {
+		h, ok = script[0], true
+		s.scripts[name] = script[1:]
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		return Fail(59)(cmd)
+	}
+
+	return h(cmd)
+}
+
+// commandName returns the first key of the command, with the legacy hello names normalized.
+func commandName(cmd bson.Raw) string {
+	elems, err := cmd.Elements()
+	if err != nil || len(elems) == 0 {
+		return ""
+	}
+
+	name := elems[0].Key()
+	if name == "isMaster" || name == "ismaster" {
+		return "hello"
+	}
+
+	return name
+}
+
+/* WIRE PROTOCOL */
+
+func readMessage(r io.Reader) ([]byte, error) {
+	var size [4]byte
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		return nil, err
+	}
+
+	length := int32(binary.LittleEndian.Uint32(size[:]))
+	if length < 16 {
+		return nil, fmt.Errorf("invalid message length %d", length)
+	}
+
+	wm := make([]byte, length)
+	copy(wm, size[:])
+
+	if _, err := io.ReadFull(r, wm[4:]); err != nil {
+		return nil, err
+	}
+
+	return wm, nil
+}
+
+// readMsgBody returns the body section of an OP_MSG. Document sequences, e.g. the documents
+// of an insert, are appended to the body as arrays, as the server does.
+func readMsgBody(src []byte) (bson.Raw, error) {
+	flags, rem, ok := wiremessage.ReadMsgFlags(src)
+	if !ok {
+		return nil, errors.New("malformed OP_MSG flags")
+	}
+
+	if flags&wiremessage.ChecksumPresent != 0 {
+		rem = rem[:len(rem)-4]
+	}
+
+	var body bsoncore.Document
+	var sequences [][]byte
+
+	for len(rem) > 0 {
+		var stype wiremessage.SectionType
+
+		stype, rem, ok = wiremessage.ReadMsgSectionType(rem)
+		if !ok {
+			return nil, errors.New("malformed OP_MSG section")
+		}
+
+		switch stype {
+		case wiremessage.SingleDocument:
+			body, rem, ok = wiremessage.ReadMsgSectionSingleDocument(rem)
+		case wiremessage.DocumentSequence:
+			var identifier string
+			var docs []bsoncore.Document
+
+			identifier, docs, rem, ok = wiremessage.ReadMsgSectionDocumentSequence(rem)
+			if ok {
+				sequences = append(sequences, appendSequence(identifier, docs))
+			}
+		default:
+			return nil, fmt.Errorf("unsupported OP_MSG section type %d", stype)
+		}
+
+		if !ok {
+			return nil, errors.New("malformed OP_MSG section")
+		}
+	}
+
+	if body == nil {
+		return nil, errors.New("OP_MSG without body")
+	}
+
+	if len(sequences) == 0 {
+		return bson.Raw(body), nil
+	}
+
+	elems, err := body.Elements()
+	if err != nil {
+		return nil, err
+	}
+
+	var fields [][]byte
+	for _, e := range elems {
+		fields = append(fields, e)
+	}
+
+	return bson.Raw(bsoncore.BuildDocument(nil, append(fields, sequences...)...)), nil
+}
+
+// appendSequence returns the document sequence as an array element.
+func appendSequence(identifier string, docs []bsoncore.Document) []byte {
+	values := make([]bsoncore.Value, 0, len(docs))
+	for _, d := range docs {
+		values = append(values, bsoncore.Value{Type: bsoncore.TypeEmbeddedDocument, Data: d})
+	}
+
+	return bsoncore.AppendArrayElement(nil, identifier, bsoncore.BuildArray(nil, values...))
+}
+
+func readQueryBody(src []byte) (bson.Raw, error) {
+	_, rem, ok := wiremessage.ReadQueryFlags(src)
+	if ok {
+		_, rem, ok = wiremessage.ReadQueryFullCollectionName(rem)
+	}
+	if ok {
+		_, rem, ok = wiremessage.ReadQueryNumberToSkip(rem)
+	}
+	if ok {
+		_, rem, ok = wiremessage.ReadQueryNumberToReturn(rem)
+	}
+
+	var query bsoncore.Document
+	if ok {
+		query, _, ok = wiremessage.ReadQueryQuery(rem)
+	}
+
+	if !ok {
+		return nil, errors.New("malformed OP_QUERY")
+	}
+
+	return bson.Raw(query), nil
+}
+
+func appendMsgReply(responseTo int32, reply bson.D) ([]byte, error) {
+	doc, err := bson.Marshal(reply)
+	if err != nil {
+		return nil, err
+	}
+
+	idx, wm := wiremessage.AppendHeaderStart(nil, wiremessage.NextRequestID(), responseTo, wiremessage.OpMsg)
+	wm = wiremessage.AppendMsgFlags(wm, 0)
+	wm = wiremessage.AppendMsgSectionType(wm, wiremessage.SingleDocument)
+	wm = append(wm, doc...)
+
+	return bsoncore.UpdateLength(wm, idx, int32(len(wm[idx:]))), nil
+}
+
+func appendQueryReply(responseTo int32, reply bson.D) ([]byte, error) {
+	doc, err := bson.Marshal(reply)
+	if err != nil {
+		return nil, err
+	}
+
+	idx, wm := wiremessage.AppendHeaderStart(nil, wiremessage.NextRequestID(), responseTo, wiremessage.OpReply)
+	wm = wiremessage.AppendReplyFlags(wm, 0)
+	wm = wiremessage.AppendReplyCursorID(wm, 0)
+	wm = wiremessage.AppendReplyStartingFrom(wm, 0)
+	wm = wiremessage.AppendReplyNumberReturned(wm, 1)
+	wm = append(wm, doc...)
+
+	return bsoncore.UpdateLength(wm, idx, int32(len(wm[idx:]))), nil
+}
@@ -0,0 +1,144 @@
+package mongotest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func connect(t *testing.T, uri string) *mongo.Client {
+	t.Helper()
+
+	c, err := mongo.Connect(options.Client().ApplyURI(uri))
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+
+	t.Cleanup(func() {
+		_ = c.Disconnect(context.Background())
+	})
+
+	return c
+}
+
+func TestServerDefaults(t *testing.T) {
+	for _, replicaSet := range []string{"", "rs0"} {
+		t.Run("replica set "+replicaSet, func(t *testing.T) {
+			ctx := context.Background()
+			srv := NewServer(t)
+			srv.SetReplicaSet(replicaSet)
+
+			c := connect(t, srv.URI())
+
+			if err := c.Ping(ctx, nil); err != nil {
+				t.Fatalf("ping failed: %s", err)
+			}
+
+			var info struct {
+				Version string `bson:"version"`
+			}
+
+			err := c.Database("admin").RunCommand(ctx, bson.D{{Key: "buildInfo", Value: 1}}).Decode(&info)
+			if err != nil || info.Version != Version {
+				t.Fatalf("expected version %s, got %q, error: %v", Version, info.Version, err)
+			}
+
+			if _, err := c.Database("app").Collection("test").InsertOne(ctx, bson.D{{Key: "a", Value: 1}}); err != nil {
+				t.Fatalf("insert failed: %s", err)
+			}
+
+			if srv.Calls("insert") != 1 {
+				t.Fatalf("expected 1 insert, got %d", srv.Calls("insert"))
+			}
+		})
+	}
+}
+
+func TestServerScript(t *testing.T) {
+	ctx := context.Background()
+	srv := NewServer(t)
+	srv.Script("usersInfo", Fail(13, "TransientTransactionError"), Disconnect)
+
+	c := connect(t, srv.URI())
+	cmd := bson.D{{Key: "usersInfo", Value: 1}}
+
+	var commandErr mongo.CommandError
+
+	err := c.Database("admin").RunCommand(ctx, cmd).Err()
+	if !errors.As(err, &commandErr) || commandErr.Code != 13 || !commandErr.HasErrorLabel("TransientTransactionError") {
+		t.Fatalf("expected command error 13 with label, got %v", err)
+	}
+
+	err = c.Database("admin").RunCommand(ctx, cmd).Err()
+	if !mongo.IsNetworkError(err) {
+		t.Fatalf("expected network error, got %v", err)
+	}
+
+	if err := c.Database("admin").RunCommand(ctx, cmd).Err(); err != nil {
+		t.Fatalf("expected default reply, got %v", err)
+	}
+
+	if srv.Calls("usersInfo") != 3 {
+		t.Fatalf("expected 3 calls, got %d", srv.Calls("usersInfo"))
+	}
+}
+
+func TestServerUnknownCommand(t *testing.T) {
+	srv := NewServer(t)
+	c := connect(t, srv.URI())
+
+	var commandErr mongo.CommandError
+
+	err := c.Database("admin").RunCommand(context.Background(), bson.D{{Key: "unknown", Value: 1}}).Err()
+	if !errors.As(err, &commandErr) || commandErr.Code != 59 {
+		t.Fatalf("expected CommandNotFound, got %v", err)
+	}
+}
+
+func TestServerCursor(t *testing.T) {
+	ctx := context.Background()
+	srv := NewServer(t)
+
+	c := connect(t, srv.URI())
+	collection := c.Database("config").Collection("collections")
+
+	if err := collection.FindOne(ctx, bson.D{}).Err(); !errors.Is(err, mongo.ErrNoDocuments) {
+		t.Fatalf("expected no documents by default, got %v", err)
+	}
+
+	srv.Handle("find", Cursor(bson.D{{Key: "_id", Value: "app.orders"}}))
+
+	var doc struct {
+		ID string `bson:"_id"`
+	}
+
+	if err := collection.FindOne(ctx, bson.D{}).Decode(&doc); err != nil || doc.ID != "app.orders" {
+		t.Fatalf("expected app.orders, got %q, error: %v", doc.ID, err)
+	}
+
+	if err := collection.FindOne(ctx, bson.D{{Key: "_id", Value: "app.users"}}).Err(); !errors.Is(err, mongo.ErrNoDocuments) {
+		t.Fatalf("expected documents filtered by _id, got %v", err)
+	}
+}
+
+func TestServerCollections(t *testing.T) {
+	ctx := context.Background()
+	srv := NewServer(t)
+	srv.Handle("find", Collections(map[string][]bson.D{
+		"config.shards": {{{Key: "_id", Value: "shard01"}}},
+	}))
+
+	c := connect(t, srv.URI())
+
+	if err := c.Database("config").Collection("tags").FindOne(ctx, bson.D{}).Err(); !errors.Is(err, mongo.ErrNoDocuments) {
+		t.Fatalf("expected no tags, got %v", err)
+	}
+
+	if err := c.Database("config").Collection("shards").FindOne(ctx, bson.D{}).Err(); err != nil {
+		t.Fatalf("expected a shard, got %v", err)
+	}
+}
@@ -0,0 +1,26 @@
+package types
+
+import "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+
+const (
+	BalancerModeOff = "off"
+)
+
+/* BALANCER */
+
+// Balancer is the balancer configuration of a sharded cluster. Unset attributes are the MongoDB defaults:
+// no active window, the default chunk size and balancing enabled for every collection.
+type Balancer struct {
+	Enabled             bool            `tfsdk:"enabled"`
+	ActiveWindow        *BalancerWindow `tfsdk:"active_window"`
+	ChunkSizeMB         *int64          `tfsdk:"chunk_size_mb"`
+	DisabledCollections []string        `tfsdk:"disabled_collections"`
+	Retry               *Retry          `tfsdk:"retry"`
+	Timeouts            timeouts.Value  `tfsdk:"timeouts"`
+}
+
+// BalancerWindow is the time of day in the HH:MM format during which the balancer runs.
+type BalancerWindow struct {
+	Start string `tfsdk:"start" bson:"start"`
+	Stop  string `tfsdk:"stop" bson:"stop"`
+}
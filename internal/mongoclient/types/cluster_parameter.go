@@ -0,0 +1,15 @@
+package types
+
+import "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+
+/* CLUSTER PARAMETER */
+
+// ClusterParameter is a cluster-wide parameter set with setClusterParameter. Value and PreviousValue are documents
+// in MongoDB Extended JSON, PreviousValue is restored on delete.
+type ClusterParameter struct {
+	Name          string         `tfsdk:"name"`
+	Value         string         `tfsdk:"value"`
+	PreviousValue *string        `tfsdk:"previous_value"`
+	Retry         *Retry         `tfsdk:"retry"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,24 @@
+package types
+
+import "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+
+/* CLUSTERED COLLECTION */
+
+// ClusteredCollectionDefaultIndexName is the name MongoDB gives the clustered index if none is set.
+const ClusteredCollectionDefaultIndexName = "_id_"
+
+// ClusteredCollection is a collection stored in the order of its clustered _id index. With ExpireAfterSeconds
+// documents are deleted once their _id is older, without a secondary TTL index.
+type ClusteredCollection struct {
+	Database           string         `tfsdk:"database"`
+	Name               string         `tfsdk:"name"`
+	IndexName          *string        `tfsdk:"index_name"`
+	ExpireAfterSeconds *int64         `tfsdk:"expire_after_seconds"`
+	Retry              *Retry         `tfsdk:"retry"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Namespace returns the <database>.<name> namespace of the collection.
+func (c *ClusteredCollection) Namespace() string {
+	return c.Database + "." + c.Name
+}
@@ -0,0 +1,55 @@
+package types
+
+/* COLLECTIONS */
+
+// Collections is the state of the collections data source, the collections and views of a database.
+type Collections struct {
+	Database    string       `tfsdk:"database"`
+	NameRegex   *string      `tfsdk:"name_regex"`
+	Statistics  *bool        `tfsdk:"statistics"`
+	Collections []Collection `tfsdk:"collections"`
+}
+
+// Collection is a collection, view or time-series collection. Options and Validator are documents
+// in relaxed Extended JSON, the options without the validator.
+type Collection struct {
+	Name      string           `tfsdk:"name"`
+	Type      string           `tfsdk:"type"`
+	Options   string           `tfsdk:"options"`
+	Validator *string          `tfsdk:"validator"`
+	Stats     *CollectionStats `tfsdk:"stats"`
+}
+
+// CollectionStats are the storage statistics of a collection reported by $collStats.
+type CollectionStats struct {
+	Count          int64 `tfsdk:"count"`
+	Size           int64 `tfsdk:"size"`
+	StorageSize    int64 `tfsdk:"storage_size"`
+	TotalIndexSize int64 `tfsdk:"total_index_size"`
+	Indexes        int64 `tfsdk:"indexes"`
+}
+
+// WithStatistics reports whether the statistics of the collections are read.
+func (c *Collections) WithStatistics() bool {
+	return c.Statistics != nil && *c.Statistics
+}
+
+/* INDEXES */
+
+// Indexes is the state of the indexes data source, the indexes of a collection or of all collections of a database.
+type Indexes struct {
+	Database   string  `tfsdk:"database"`
+	Collection *string `tfsdk:"collection"`
+	NameRegex  *string `tfsdk:"name_regex"`
+	Indexes    []Index `tfsdk:"indexes"`
+}
+
+// Index is an index of a collection. Key and Options are documents in relaxed Extended JSON,
+// the options without the key, name and version of the index.
+type Index struct {
+	Collection string `tfsdk:"collection"`
+	Name       string `tfsdk:"name"`
+	Key        string `tfsdk:"key"`
+	Options    string `tfsdk:"options"`
+	Size       int64  `tfsdk:"size"`
+}
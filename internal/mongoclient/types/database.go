@@ -5,13 +5,103 @@ import "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeou
 var (
 	DefaultDatabases = []string{"admin", "config", "local"} // Default databases to exclude from listing
 	DefaultDatabase  = "admin"                              // Default database for making queries
+	ConfigDatabase   = "config"                             // Database with the metadata of a sharded cluster
 )
 
-type Databases struct {
-	Databases []Database `tfsdk:"databases"`
-}
+// Marker modes of a database, which decide when the marker collection materialising an empty database is created
+// and dropped.
+const (
+	DatabaseMarkerModeKeep   = "keep"   // The marker is always created and kept
+	DatabaseMarkerModeRemove = "remove" // The marker is created without declared collections and dropped once another collection exists
+	DatabaseMarkerModeNone   = "none"   // The marker is never created, the database needs declared collections
+
+	DefaultDatabaseMarkerCollection = "created_by_terraform"
+)
+
+var DatabaseMarkerModes = []string{DatabaseMarkerModeKeep, DatabaseMarkerModeRemove, DatabaseMarkerModeNone}
 
 type Database struct {
-	Name     string         `tfsdk:"name"`
-	Timeouts timeouts.Value `tfsdk:"timeouts" bson:"-"`
+	Name               string         `tfsdk:"name"`
+	Collections        []string       `tfsdk:"collections" bson:"-"`
+	MarkerCollection   *string        `tfsdk:"marker_collection" bson:"-"`
+	MarkerMode         *string        `tfsdk:"marker_mode" bson:"-"`
+	MarkerPresent      *bool          `tfsdk:"marker_present" bson:"-"`
+	DeletionProtection *bool          `tfsdk:"deletion_protection" bson:"-"`
+	DropOnlyIfEmpty    *bool          `tfsdk:"drop_only_if_empty" bson:"-"`
+	Retry              *Retry         `tfsdk:"retry" bson:"-"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts" bson:"-"`
+}
+
+// IsDeletionProtected reports whether destroying the resource is refused.
+func (d *Database) IsDeletionProtected() bool {
+	return d.DeletionProtection != nil && *d.DeletionProtection
+}
+
+// IsDropOnlyIfEmpty reports whether the database is dropped only if it holds no documents besides the marker.
+func (d *Database) IsDropOnlyIfEmpty() bool {
+	return d.DropOnlyIfEmpty != nil && *d.DropOnlyIfEmpty
+}
+
+// Marker returns the name of the marker collection, by default created_by_terraform.
+func (d *Database) Marker() string {
+	if d.MarkerCollection == nil {
+		return DefaultDatabaseMarkerCollection
+	}
+
+	return *d.MarkerCollection
+}
+
+// Mode returns the marker mode, by default keep.
+func (d *Database) Mode() string {
+	if d.MarkerMode == nil {
+		return DatabaseMarkerModeKeep
+	}
+
+	return *d.MarkerMode
+}
+
+// IsMarkerPresent reports whether the remove mode left the marker collection next to another collection.
+func (d *Database) IsMarkerPresent() bool {
+	return d.MarkerPresent != nil && *d.MarkerPresent
+}
+
+// NeedsMarker reports whether the marker collection is created with the database.
+func (d *Database) NeedsMarker() bool {
+	switch d.Mode() {
+	case DatabaseMarkerModeNone:
+		return false
+	case DatabaseMarkerModeRemove:
+		return len(d.Collections) == 0
+	default:
+		return true
+	}
+}
+
+// DataSourceDatabases is the state of the databases data source, which has no resource-only attributes
+type DataSourceDatabases struct {
+	NameRegex  *string              `tfsdk:"name_regex"`
+	Statistics *bool                `tfsdk:"statistics"`
+	Databases  []DataSourceDatabase `tfsdk:"databases"`
+}
+
+type DataSourceDatabase struct {
+	Name  string         `tfsdk:"name"`
+	Stats *DatabaseStats `tfsdk:"stats"`
+}
+
+// DatabaseStats are the statistics of a database. The size on disk and the empty flag are reported
+// by listDatabases, the other fields by dbStats.
+type DatabaseStats struct {
+	SizeOnDisk  int64 `tfsdk:"size_on_disk" bson:"-"`
+	DataSize    int64 `tfsdk:"data_size" bson:"dataSize"`
+	StorageSize int64 `tfsdk:"storage_size" bson:"storageSize"`
+	IndexSize   int64 `tfsdk:"index_size" bson:"indexSize"`
+	Collections int64 `tfsdk:"collections" bson:"collections"`
+	Objects     int64 `tfsdk:"objects" bson:"objects"`
+	Empty       bool  `tfsdk:"empty" bson:"-"`
+}
+
+// WithStatistics reports whether the statistics of the databases are read.
+func (d *DataSourceDatabases) WithStatistics() bool {
+	return d.Statistics != nil && *d.Statistics
 }
@@ -0,0 +1,41 @@
+package types
+
+import "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+
+const (
+	WriteConcernMajority = "majority"
+
+	// DefaultRWConcernSourceGlobal is the source of a default set with setDefaultRWConcern,
+	// as opposed to the implicit default of the server.
+	DefaultRWConcernSourceGlobal = "global"
+)
+
+/* DEFAULT READ/WRITE CONCERN */
+
+// DefaultRWConcern is the cluster-wide default read and write concern. The write concern can't be unset
+// once set, so it is required. An unset read concern is the implicit default of the server.
+type DefaultRWConcern struct {
+	WriteConcern WriteConcern   `tfsdk:"default_write_concern"`
+	ReadConcern  *ReadConcern   `tfsdk:"default_read_concern"`
+	Retry        *Retry         `tfsdk:"retry"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// WriteConcern is a write concern. W is a number of members, majority or a custom write concern name,
+// unset when the server reports no default write concern.
+type WriteConcern struct {
+	W        *string `tfsdk:"w"`
+	J        *bool   `tfsdk:"j"`
+	WTimeout *int64  `tfsdk:"wtimeout"`
+}
+
+// MajorityWriteConcern returns the majority write concern, the implicit default of replica sets without arbiters.
+func MajorityWriteConcern() WriteConcern {
+	w := WriteConcernMajority
+
+	return WriteConcern{W: &w}
+}
+
+type ReadConcern struct {
+	Level string `tfsdk:"level"`
+}
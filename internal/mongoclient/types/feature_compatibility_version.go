@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+/* FEATURE COMPATIBILITY VERSION */
+
+// FeatureCompatibilityVersion is the feature compatibility version of the cluster, e.g. 6.0.
+// Downgrades are refused unless AllowDowngrade is set.
+type FeatureCompatibilityVersion struct {
+	Version        string         `tfsdk:"version"`
+	AllowDowngrade *bool          `tfsdk:"allow_downgrade"`
+	Retry          *Retry         `tfsdk:"retry"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// IsDowngradeAllowed checks if the version may be lowered.
+func (f *FeatureCompatibilityVersion) IsDowngradeAllowed() bool {
+	return f.AllowDowngrade != nil && *f.AllowDowngrade
+}
+
+// CompareFeatureCompatibilityVersions compares the <major>.<minor> versions and returns -1, 0 or 1
+// when a is lower, equal or higher than b.
+func CompareFeatureCompatibilityVersions(a, b string) (int, error) {
+	va, err := parseFeatureCompatibilityVersion(a)
+	if err != nil {
+		return 0, err
+	}
+
+	vb, err := parseFeatureCompatibilityVersion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range va {
+		if va[i] != vb[i] {
+			if va[i] < vb[i] {
+				return -1, nil
+			}
+
+			return 1, nil
+		}
+	}
+
+	return 0, nil
+}
+
+func parseFeatureCompatibilityVersion(v string) ([2]int, error) {
+	var parsed [2]int
+
+	parts := strings.Split(v, ".")
+	if len(parts) != 2 {
+		return parsed, fmt.Errorf("invalid feature compatibility version %q, expected <major>.<minor>", v)
+	}
+
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return parsed, fmt.Errorf("invalid feature compatibility version %q, expected <major>.<minor>", v)
+		}
+
+		parsed[i] = n
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,24 @@
+package types
+
+import "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+
+// Profiler defaults of mongod, which an unset slowms or sample rate keeps.
+const (
+	ProfilerDefaultSlowMS     = 100
+	ProfilerDefaultSampleRate = 1.0
+)
+
+/* PROFILER */
+
+// Profiler is the profiler configuration of a database set with the profile command. The level and the filter
+// are set per database, slowms and the sample rate are shared by all databases of the server.
+// Filter is a query document in MongoDB Extended JSON.
+type Profiler struct {
+	Database   string         `tfsdk:"database"`
+	Level      int64          `tfsdk:"level"`
+	SlowMS     *int64         `tfsdk:"slowms"`
+	SampleRate *float64       `tfsdk:"sample_rate"`
+	Filter     *string        `tfsdk:"filter"`
+	Retry      *Retry         `tfsdk:"retry"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
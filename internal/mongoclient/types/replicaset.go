@@ -16,9 +16,19 @@ type ReplicaSet struct {
 	ProtocolVersion                    *int64         `tfsdk:"protocol_version" bson:"protocolVersion,omitempty"`
 	WriteConcernMajorityJournalDefault *bool          `tfsdk:"write_concern_majority_journal_default" bson:"writeConcernMajorityJournalDefault,omitempty"`
 	Settings                           *Settings      `tfsdk:"settings" bson:"settings,omitempty"`
+	Retry                              *Retry         `tfsdk:"retry" bson:"-"`
 	Timeouts                           timeouts.Value `tfsdk:"timeouts" bson:"-"`
 }
 
+// DataSourceReplicaSet is the state of the replica set data source, which has no version and resource-only attributes
+type DataSourceReplicaSet struct {
+	Name                               string    `tfsdk:"name"`
+	Members                            []Member  `tfsdk:"members"`
+	ProtocolVersion                    *int64    `tfsdk:"protocol_version"`
+	WriteConcernMajorityJournalDefault *bool     `tfsdk:"write_concern_majority_journal_default"`
+	Settings                           *Settings `tfsdk:"settings"`
+}
+
 type Member struct {
 	Id                 int64    `tfsdk:"id" bson:"_id"`
 	Host               string   `tfsdk:"host" bson:"host"`
@@ -53,6 +63,16 @@ func (r *ReplicaSet) ClearVersion() {
 	r.Version = nil
 }
 
+func (r *ReplicaSet) ToDataSource() DataSourceReplicaSet {
+	return DataSourceReplicaSet{
+		Name:                               r.Name,
+		Members:                            r.Members,
+		ProtocolVersion:                    r.ProtocolVersion,
+		WriteConcernMajorityJournalDefault: r.WriteConcernMajorityJournalDefault,
+		Settings:                           r.Settings,
+	}
+}
+
 func (r *ReplicaSet) RemoveDefaults() {
 	r.ClearVersion()
 
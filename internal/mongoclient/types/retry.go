@@ -3,4 +3,17 @@ package types
 const (
 	RetryAttempts = 5
 	RetryDelaySec = 3
+
+	RetryBackoffFixed       = "fixed"
+	RetryBackoffExponential = "exponential"
 )
+
+// Retry is the retry policy configured in the provider block or overridden in a resource.
+// Unset fields fall back to the provider policy, then to the defaults above.
+type Retry struct {
+	Attempts    *int64  `tfsdk:"attempts"`
+	DelaySec    *int64  `tfsdk:"delay_sec"`
+	MaxDelaySec *int64  `tfsdk:"max_delay_sec"`
+	JitterSec   *int64  `tfsdk:"jitter_sec"`
+	Backoff     *string `tfsdk:"backoff"`
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+// AllMembersHost is the host part of the import ID of a parameter set on every replica set member.
+const AllMembersHost = "*"
+
+/* SERVER PARAMETER */
+
+// ServerParameter is a runtime parameter set with setParameter. Values are in MongoDB Extended JSON.
+// PreviousValues holds the value of each host before the parameter was set, which is restored on delete.
+type ServerParameter struct {
+	Name           string            `tfsdk:"name"`
+	Value          string            `tfsdk:"value"`
+	Host           *string           `tfsdk:"host"`
+	AllMembers     *bool             `tfsdk:"all_members"`
+	PreviousValues map[string]string `tfsdk:"previous_values"`
+	Retry          *Retry            `tfsdk:"retry"`
+	Timeouts       timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// IsAllMembers checks if the parameter is set on every replica set member.
+func (p *ServerParameter) IsAllMembers() bool {
+	return p.AllMembers != nil && *p.AllMembers
+}
+
+// ParseServerParameterID parses the <name>, <host>/<name> or */<name> import ID.
+func ParseServerParameterID(id string) (ServerParameter, bool) {
+	p := ServerParameter{Name: id}
+
+	if i := strings.LastIndex(id, "/"); i >= 0 {
+		host := id[:i]
+		p.Name = id[i+1:]
+
+		if host == "" {
+			return p, false
+		}
+
+		if host == AllMembersHost {
+			allMembers := true
+			p.AllMembers = &allMembers
+		} else {
+			p.Host = &host
+		}
+	}
+
+	return p, p.Name != ""
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+const (
+	ShardRemovalStarted   = "started"
+	ShardRemovalOngoing   = "ongoing"
+	ShardRemovalCompleted = "completed"
+)
+
+/* SHARD */
+
+type Shards struct {
+	Shards []Shard `bson:"shards"`
+}
+
+type Shard struct {
+	Name     string         `tfsdk:"name" bson:"_id"`
+	Host     string         `tfsdk:"host" bson:"host"`
+	Retry    *Retry         `tfsdk:"retry" bson:"-"`
+	Timeouts timeouts.Value `tfsdk:"timeouts" bson:"-"`
+}
+
+// ShardRemoval is the progress reported by each removeShard call while the shard is drained.
+type ShardRemoval struct {
+	State     string   `bson:"state"`
+	Msg       string   `bson:"msg"`
+	DbsToMove []string `bson:"dbsToMove"`
+	Remaining struct {
+		Chunks      int64 `bson:"chunks"`
+		Dbs         int64 `bson:"dbs"`
+		JumboChunks int64 `bson:"jumboChunks"`
+	} `bson:"remaining"`
+}
+
+func (s *Shards) Exist(name string) bool {
+	return s.Get(name) != nil
+}
+
+func (s *Shards) Get(name string) *Shard {
+	for _, shard := range s.Shards {
+		if shard.Name == name {
+			return &shard
+		}
+	}
+
+	return nil
+}
+
+// ShardReplicaSet returns the replica set of a shard host in the <replica set>/<host:port>,<host:port> format,
+// or the host itself if it names no replica set.
+func ShardReplicaSet(host string) string {
+	if rs, _, ok := strings.Cut(host, "/"); ok {
+		return rs
+	}
+
+	return host
+}
+
+// SameShardHost reports whether the hosts name the same shard. listShards returns every member of the
+// replica set, while addShard takes any of them, so hosts are compared by replica set.
+func SameShardHost(a, b string) bool {
+	return ShardReplicaSet(a) == ShardReplicaSet(b)
+}
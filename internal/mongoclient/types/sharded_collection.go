@@ -0,0 +1,49 @@
+package types
+
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+const (
+	ShardKeyAscending = "ascending"
+	ShardKeyHashed    = "hashed"
+)
+
+/* SHARDED COLLECTION */
+
+type ShardedCollection struct {
+	Database            string         `tfsdk:"database"`
+	Collection          string         `tfsdk:"collection"`
+	Key                 ShardKey       `tfsdk:"key"`
+	Unique              *bool          `tfsdk:"unique"`
+	NumInitialChunks    *int64         `tfsdk:"num_initial_chunks"`
+	PresplitHashedZones *bool          `tfsdk:"presplit_hashed_zones"`
+	Collation           *string        `tfsdk:"collation"`
+	Retry               *Retry         `tfsdk:"retry"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ShardKey is the ordered list of shard key fields.
+type ShardKey []ShardKeyField
+
+type ShardKeyField struct {
+	Field string `tfsdk:"field"`
+	Type  string `tfsdk:"type"`
+}
+
+// Namespace returns the <database>.<collection> namespace of the collection.
+func (c *ShardedCollection) Namespace() string {
+	return c.Database + "." + c.Collection
+}
+
+func (k ShardKey) Equal(other ShardKey) bool {
+	return slices.Equal(k, other)
+}
+
+// Refines checks if the key can be changed to next with refineCollectionShardKey,
+// which only appends fields to the end of the current key.
+func (k ShardKey) Refines(next ShardKey) bool {
+	return len(next) > len(k) && slices.Equal(k, next[:len(k)])
+}
@@ -0,0 +1,43 @@
+package types
+
+const (
+	ShardStateActive   = "active"
+	ShardStateDraining = "draining"
+)
+
+/* SHARDING */
+
+// Sharding is the state of the sharding data source, the topology of a sharded cluster.
+type Sharding struct {
+	Shards      []ShardingShard      `tfsdk:"shards"`
+	Databases   []ShardingDatabase   `tfsdk:"databases"`
+	Collections []ShardingCollection `tfsdk:"collections"`
+	Balancer    BalancerStatus       `tfsdk:"balancer"`
+}
+
+type ShardingShard struct {
+	Name   string   `tfsdk:"name"`
+	Host   string   `tfsdk:"host"`
+	State  string   `tfsdk:"state"`
+	Zones  []string `tfsdk:"zones"`
+	Chunks int64    `tfsdk:"chunks"`
+}
+
+type ShardingDatabase struct {
+	Name         string `tfsdk:"name"`
+	PrimaryShard string `tfsdk:"primary_shard"`
+}
+
+type ShardingCollection struct {
+	Database   string           `tfsdk:"database"`
+	Collection string           `tfsdk:"collection"`
+	Key        ShardKey         `tfsdk:"key"`
+	Unique     bool             `tfsdk:"unique"`
+	Balancing  bool             `tfsdk:"balancing"`
+	Chunks     map[string]int64 `tfsdk:"chunks"`
+}
+
+type BalancerStatus struct {
+	Mode            string `tfsdk:"mode"`
+	InBalancerRound bool   `tfsdk:"in_balancer_round"`
+}
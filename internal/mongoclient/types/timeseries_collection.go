@@ -0,0 +1,70 @@
+package types
+
+import "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+
+/* TIME-SERIES COLLECTION */
+
+// Time-series granularities, from the finest to the coarsest.
+const (
+	TimeSeriesGranularitySeconds = "seconds"
+	TimeSeriesGranularityMinutes = "minutes"
+	TimeSeriesGranularityHours   = "hours"
+)
+
+// TimeSeriesGranularities are the granularities in the order they may be upgraded.
+var TimeSeriesGranularities = []string{
+	TimeSeriesGranularitySeconds,
+	TimeSeriesGranularityMinutes,
+	TimeSeriesGranularityHours,
+}
+
+// TimeSeriesCollection is a collection storing measurements in buckets. The buckets are sized either
+// by Granularity or by the custom BucketMaxSpanSeconds and BucketRoundingSeconds, if neither is set
+// the granularity is seconds.
+type TimeSeriesCollection struct {
+	Database              string         `tfsdk:"database"`
+	Name                  string         `tfsdk:"name"`
+	TimeField             string         `tfsdk:"time_field"`
+	MetaField             *string        `tfsdk:"meta_field"`
+	Granularity           *string        `tfsdk:"granularity"`
+	BucketMaxSpanSeconds  *int64         `tfsdk:"bucket_max_span_seconds"`
+	BucketRoundingSeconds *int64         `tfsdk:"bucket_rounding_seconds"`
+	ExpireAfterSeconds    *int64         `tfsdk:"expire_after_seconds"`
+	Retry                 *Retry         `tfsdk:"retry"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Namespace returns the <database>.<name> namespace of the collection.
+func (c *TimeSeriesCollection) Namespace() string {
+	return c.Database + "." + c.Name
+}
+
+// HasCustomBuckets reports whether the buckets are sized by the bucket settings instead of the granularity.
+func (c *TimeSeriesCollection) HasCustomBuckets() bool {
+	return c.BucketMaxSpanSeconds != nil || c.BucketRoundingSeconds != nil
+}
+
+// CompareTimeSeriesGranularities returns -1, 0 or 1 if granularity a is finer than, equal to or coarser than b.
+// Unknown granularities are finer than every known one.
+func CompareTimeSeriesGranularities(a, b string) int {
+	rank := func(g string) int {
+		for i, known := range TimeSeriesGranularities {
+			if g == known {
+				return i
+			}
+		}
+
+		return -1
+	}
+
+	ra, rb := rank(a), rank(b)
+
+	switch {
+	case ra < rb:
+		return -1
+	case ra > rb:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -16,9 +16,21 @@ type User struct {
 	Username string         `tfsdk:"username" bson:"user"`
 	Password string         `tfsdk:"password" bson:"password,omitempty"`
 	Roles    []Role         `tfsdk:"roles" bson:"roles"`
+	Retry    *Retry         `tfsdk:"retry" bson:"-"`
 	Timeouts timeouts.Value `tfsdk:"timeouts" bson:"-"`
 }
 
+// DataSourceUsers is the state of the users data source, which has no resource-only attributes
+type DataSourceUsers struct {
+	Users []DataSourceUser `tfsdk:"users"`
+}
+
+type DataSourceUser struct {
+	Username string `tfsdk:"username"`
+	Password string `tfsdk:"password"`
+	Roles    []Role `tfsdk:"roles"`
+}
+
 type Role struct {
 	Role     string `tfsdk:"role" bson:"role"`
 	Database string `tfsdk:"database" bson:"db"`
@@ -34,6 +46,19 @@ func (u *Users) Exist(username string) bool {
 	return false
 }
 
+func (u *Users) ToDataSource() DataSourceUsers {
+	us := DataSourceUsers{}
+	for _, user := range u.Users {
+		us.Users = append(us.Users, DataSourceUser{
+			Username: user.Username,
+			Password: user.Password,
+			Roles:    user.Roles,
+		})
+	}
+
+	return us
+}
+
 func (u *Users) Get(username string) *User {
 	for _, user := range u.Users {
 		if user.Username == username {
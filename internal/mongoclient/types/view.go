@@ -0,0 +1,22 @@
+package types
+
+import "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+
+/* VIEW */
+
+// View is a read-only view on a collection or another view. Pipeline is the aggregation pipeline
+// as an array in MongoDB Extended JSON.
+type View struct {
+	Database  string         `tfsdk:"database"`
+	Name      string         `tfsdk:"name"`
+	ViewOn    string         `tfsdk:"view_on"`
+	Pipeline  string         `tfsdk:"pipeline"`
+	Collation *string        `tfsdk:"collation"`
+	Retry     *Retry         `tfsdk:"retry"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Namespace returns the <database>.<name> namespace of the view.
+func (v *View) Namespace() string {
+	return v.Database + "." + v.Name
+}
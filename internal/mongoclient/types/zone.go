@@ -0,0 +1,29 @@
+package types
+
+import "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+
+/* ZONES */
+
+type ShardZone struct {
+	Shard    string         `tfsdk:"shard"`
+	Zone     string         `tfsdk:"zone"`
+	Retry    *Retry         `tfsdk:"retry"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ZoneKeyRange is a range of shard key values pinned to a zone. The bounds are documents
+// with the shard key fields in MongoDB Extended JSON, min is inclusive and max is exclusive.
+type ZoneKeyRange struct {
+	Database   string         `tfsdk:"database"`
+	Collection string         `tfsdk:"collection"`
+	Zone       string         `tfsdk:"zone"`
+	Min        string         `tfsdk:"min"`
+	Max        string         `tfsdk:"max"`
+	Retry      *Retry         `tfsdk:"retry"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Namespace returns the <database>.<collection> namespace of the range.
+func (r *ZoneKeyRange) Namespace() string {
+	return r.Database + "." + r.Collection
+}
@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"terraform-provider-mongodb/internal/mongoclient/interfaces"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &resourceBalancer{}
+	_ resource.ResourceWithConfigure   = &resourceBalancer{}
+	_ resource.ResourceWithImportState = &resourceBalancer{}
+)
+
+var balancerWindowTimeValidators = []validator.String{
+	stringvalidator.RegexMatches(
+		regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`),
+		"Time must be in the HH:MM format, e.g. 23:00",
+	),
+}
+
+func ResourceBalancer() resource.Resource {
+	return &resourceBalancer{}
+}
+
+type resourceBalancer struct {
+	client interfaces.Client
+}
+
+func (r *resourceBalancer) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_balancer"
+}
+
+func (r *resourceBalancer) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the balancer of a sharded cluster. The provider must be connected to a mongos. " +
+			"There is one balancer per cluster, so declare a single resource. Unset attributes are the MongoDB defaults, " +
+			"and deleting the resource restores them: the balancer runs all day with the default chunk size and balances every collection.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether the balancer runs. Stopping it waits for the current balancing round to finish.",
+			},
+			"active_window": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The time of day during which the balancer runs, in the time zone of the config servers. By default it runs all day.",
+				Attributes: map[string]schema.Attribute{
+					"start": schema.StringAttribute{
+						Required:    true,
+						Validators:  balancerWindowTimeValidators,
+						Description: "The start of the window in the HH:MM format.",
+					},
+					"stop": schema.StringAttribute{
+						Required:    true,
+						Validators:  balancerWindowTimeValidators,
+						Description: "The end of the window in the HH:MM format. A window may span midnight, e.g. from 23:00 to 06:00.",
+					},
+				},
+			},
+			"chunk_size_mb": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1024),
+				},
+				Description: "The chunk size in megabytes. By default it is 128.",
+			},
+			"disabled_collections": schema.SetAttribute{
+				Optional:    true,
+				ElementType: tftypes.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[^.]+\..+$`),
+						"Collection must be a namespace in the <database>.<collection> format",
+					)),
+				},
+				Description: "The sharded collections in the <database>.<collection> format which the balancer skips. " +
+					"Balancing is enabled for every other collection.",
+			},
+			"retry": retryResourceAttribute(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceBalancer) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := types.Balancer{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	err := r.client.Resource().Balancer().Create(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create balancer", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceBalancer) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := types.Balancer{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	state, err := r.client.Resource().Balancer().Read(apiCtx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read balancer", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceBalancer) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := types.Balancer{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	err := r.client.Resource().Balancer().Update(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update balancer", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceBalancer) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := types.Balancer{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.Resource().Balancer().Delete(apiCtx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete balancer", err.Error())
+		return
+	}
+}
+
+// ImportState imports the balancer of the cluster, the import ID is ignored.
+func (r *resourceBalancer) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	state, err := r.client.Resource().Balancer().ImportState(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import balancer", err.Error())
+		return
+	}
+
+	state.Timeouts = nullTimeouts(resp.State)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceBalancer) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(interfaces.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected interfaces.Client, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
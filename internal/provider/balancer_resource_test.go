@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func testBalancer() types.Balancer {
+	size := int64(256)
+
+	return types.Balancer{
+		Enabled:             true,
+		ActiveWindow:        &types.BalancerWindow{Start: "23:00", Stop: "06:00"},
+		ChunkSizeMB:         &size,
+		DisabledCollections: []string{"app.orders"},
+	}
+}
+
+func TestResourceBalancerCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		sharded bool
+		wantErr bool
+	}{
+		{name: "configures balancer", sharded: true},
+		{name: "disabled collection not sharded", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+
+			r, s := newTestResource(t, ResourceBalancer, client)
+
+			if tt.sharded {
+				client.ShardCollection(testShardedCollection())
+			}
+
+			balancer := testBalancer()
+			balancer.Timeouts = testTimeouts(s, nil)
+			resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+			r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, balancer)}, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to create balancer")
+				return
+			}
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			got := client.GetBalancer()
+			if got.ActiveWindow == nil || got.ActiveWindow.Start != "23:00" || !slices.Equal(got.DisabledCollections, []string{"app.orders"}) {
+				t.Fatalf("expected balancer %+v, got %+v", balancer, got)
+			}
+		})
+	}
+}
+
+func TestResourceBalancerRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceBalancer, client)
+
+	client.SetBalancer(types.Balancer{Enabled: false})
+
+	balancer := testBalancer()
+	balancer.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, balancer)
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.Balancer
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Enabled || got.ActiveWindow != nil || got.ChunkSizeMB != nil || len(got.DisabledCollections) != 0 {
+		t.Fatalf("expected drift in state, got %+v", got)
+	}
+}
+
+func TestResourceBalancerUpdate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceBalancer, client)
+
+	client.ShardCollection(testShardedCollection())
+	client.SetBalancer(testBalancer())
+
+	state := testBalancer()
+	state.Timeouts = testTimeouts(s, nil)
+
+	plan := types.Balancer{Enabled: false, Timeouts: testTimeouts(s, nil)}
+	resp := &resource.UpdateResponse{State: newTestState(t, s, state)}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: newTestPlan(t, s, plan), State: newTestState(t, s, state)}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	got := client.GetBalancer()
+	if got.Enabled || got.ActiveWindow != nil || got.ChunkSizeMB != nil || len(got.DisabledCollections) != 0 {
+		t.Fatalf("expected stopped balancer without settings, got %+v", got)
+	}
+}
+
+func TestResourceBalancerDelete(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceBalancer, client)
+
+	client.ShardCollection(testShardedCollection())
+
+	balancer := testBalancer()
+	balancer.Enabled = false
+	client.SetBalancer(balancer)
+
+	balancer.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, balancer)
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	got := client.GetBalancer()
+	if !got.Enabled || got.ActiveWindow != nil || got.ChunkSizeMB != nil || len(got.DisabledCollections) != 0 {
+		t.Fatalf("expected default balancer, got %+v", got)
+	}
+}
+
+func TestResourceBalancerImportState(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceBalancer, client)
+
+	client.SetBalancer(testBalancer())
+
+	resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "balancer"}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.Balancer
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if !got.Enabled || got.ChunkSizeMB == nil || *got.ChunkSizeMB != 256 || !slices.Equal(got.DisabledCollections, []string{"app.orders"}) {
+		t.Fatalf("expected balancer %+v in state, got %+v", testBalancer(), got)
+	}
+}
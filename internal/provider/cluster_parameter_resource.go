@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-mongodb/internal/mongoclient/interfaces"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ resource.Resource                = &resourceClusterParameter{}
+	_ resource.ResourceWithConfigure   = &resourceClusterParameter{}
+	_ resource.ResourceWithImportState = &resourceClusterParameter{}
+)
+
+func ResourceClusterParameter() resource.Resource {
+	return &resourceClusterParameter{}
+}
+
+type resourceClusterParameter struct {
+	client interfaces.Client
+}
+
+func (r *resourceClusterParameter) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_parameter"
+}
+
+func (r *resourceClusterParameter) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sets a cluster-wide parameter with setClusterParameter, on a replica set or through a mongos. " +
+			"Cluster parameters can't be unset, so the value before the parameter was set is kept in the state and restored when the resource is deleted.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The name of the parameter, e.g. changeStreamOptions.",
+			},
+			"value": schema.StringAttribute{
+				Required: true,
+				Description: "The value document in MongoDB Extended JSON, e.g. `jsonencode({ preAndPostImages = { expireAfterSeconds = 100 } })`. " +
+					"Fields the server reports but the value doesn't set are not drift, and numbers are compared by value.",
+			},
+			"previous_value": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The value document before the parameter was set, restored when the resource is deleted.",
+			},
+			"retry": retryResourceAttribute(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceClusterParameter) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := types.ClusterParameter{}
+
+	// previous_value is unknown until the parameter is set
+	planned, err := nullUnknown(req.Plan, "previous_value")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read plan", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(planned.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	plan, err = r.client.Resource().ClusterParameter().Create(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create cluster parameter", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceClusterParameter) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := types.ClusterParameter{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	state, err := r.client.Resource().ClusterParameter().Read(apiCtx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read cluster parameter", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceClusterParameter) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := types.ClusterParameter{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	err := r.client.Resource().ClusterParameter().Update(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update cluster parameter", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceClusterParameter) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := types.ClusterParameter{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.Resource().ClusterParameter().Delete(apiCtx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete cluster parameter", err.Error())
+		return
+	}
+}
+
+// ImportState imports the parameter by name.
+func (r *resourceClusterParameter) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	state, err := r.client.Resource().ClusterParameter().ImportState(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import cluster parameter", err.Error())
+		return
+	}
+
+	state.Timeouts = nullTimeouts(resp.State)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceClusterParameter) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(interfaces.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected interfaces.Client, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	testChangeStreamOptionsOff = `{"preAndPostImages":{"expireAfterSeconds":"off"}}`
+	testChangeStreamOptions    = `{"preAndPostImages":{"expireAfterSeconds":100}}`
+)
+
+func TestResourceClusterParameterCreate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceClusterParameter, client)
+
+	client.SetClusterParameter("changeStreamOptions", testChangeStreamOptionsOff)
+
+	parameter := types.ClusterParameter{Name: "changeStreamOptions", Value: testChangeStreamOptions, Timeouts: testTimeouts(s, nil)}
+	resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+	plan := newTestPlan(t, s, parameter)
+	requireNoDiags(t, plan.SetAttribute(ctx, path.Root("previous_value"), tftypes.StringUnknown()))
+
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if got, _ := client.GetClusterParameter("changeStreamOptions"); got != testChangeStreamOptions {
+		t.Fatalf("expected changeStreamOptions %s, got %s", testChangeStreamOptions, got)
+	}
+
+	var got types.ClusterParameter
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.PreviousValue == nil || *got.PreviousValue != testChangeStreamOptionsOff {
+		t.Fatalf("expected previous value %s in state, got %v", testChangeStreamOptionsOff, got.PreviousValue)
+	}
+}
+
+func TestResourceClusterParameterCreateUnknown(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceClusterParameter, client)
+
+	parameter := types.ClusterParameter{Name: "noSuchParameter", Value: `{}`, Timeouts: testTimeouts(s, nil)}
+	resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+	r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, parameter)}, resp)
+
+	requireErrorDiag(t, resp.Diagnostics, "Failed to create cluster parameter")
+}
+
+func TestResourceClusterParameterRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceClusterParameter, client)
+
+	client.SetClusterParameter("changeStreamOptions", testChangeStreamOptionsOff)
+
+	previous := testChangeStreamOptionsOff
+	parameter := types.ClusterParameter{
+		Name:          "changeStreamOptions",
+		Value:         testChangeStreamOptions,
+		PreviousValue: &previous,
+		Timeouts:      testTimeouts(s, nil),
+	}
+	state := newTestState(t, s, parameter)
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.ClusterParameter
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Value != testChangeStreamOptionsOff || got.PreviousValue == nil {
+		t.Fatalf("expected drift keeping the previous value, got %+v", got)
+	}
+}
+
+func TestResourceClusterParameterUpdate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceClusterParameter, client)
+
+	client.SetClusterParameter("changeStreamOptions", testChangeStreamOptions)
+
+	previous := testChangeStreamOptionsOff
+	state := types.ClusterParameter{
+		Name:          "changeStreamOptions",
+		Value:         testChangeStreamOptions,
+		PreviousValue: &previous,
+		Timeouts:      testTimeouts(s, nil),
+	}
+	plan := state
+	plan.Value = `{"preAndPostImages":{"expireAfterSeconds":200}}`
+
+	resp := &resource.UpdateResponse{State: newTestState(t, s, state)}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: newTestPlan(t, s, plan), State: newTestState(t, s, state)}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if got, _ := client.GetClusterParameter("changeStreamOptions"); got != plan.Value {
+		t.Fatalf("expected changeStreamOptions %s, got %s", plan.Value, got)
+	}
+}
+
+func TestResourceClusterParameterDelete(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceClusterParameter, client)
+
+	client.SetClusterParameter("changeStreamOptions", testChangeStreamOptions)
+
+	previous := testChangeStreamOptionsOff
+	parameter := types.ClusterParameter{
+		Name:          "changeStreamOptions",
+		Value:         testChangeStreamOptions,
+		PreviousValue: &previous,
+		Timeouts:      testTimeouts(s, nil),
+	}
+	state := newTestState(t, s, parameter)
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if got, _ := client.GetClusterParameter("changeStreamOptions"); got != testChangeStreamOptionsOff {
+		t.Fatalf("expected changeStreamOptions restored to %s, got %s", testChangeStreamOptionsOff, got)
+	}
+}
+
+func TestResourceClusterParameterImportState(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceClusterParameter, client)
+
+	client.SetClusterParameter("changeStreamOptions", testChangeStreamOptions)
+
+	resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "changeStreamOptions"}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.ClusterParameter
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Name != "changeStreamOptions" || got.Value != testChangeStreamOptions {
+		t.Fatalf("expected changeStreamOptions %s in state, got %+v", testChangeStreamOptions, got)
+	}
+}
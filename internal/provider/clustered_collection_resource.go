@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-mongodb/internal/mongoclient/interfaces"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ resource.Resource                = &resourceClusteredCollection{}
+	_ resource.ResourceWithConfigure   = &resourceClusteredCollection{}
+	_ resource.ResourceWithImportState = &resourceClusteredCollection{}
+)
+
+func ResourceClusteredCollection() resource.Resource {
+	return &resourceClusteredCollection{}
+}
+
+type resourceClusteredCollection struct {
+	client interfaces.Client
+}
+
+func (r *resourceClusteredCollection) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clustered_collection"
+}
+
+func (r *resourceClusteredCollection) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a clustered collection, stored in the order of a clustered index on _id. The expiration is changed in place with collMod, " +
+			"the definition is read back from listCollections, so changes made outside Terraform are detected. Requires MongoDB 5.3.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The database of the collection.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The name of the collection.",
+			},
+			"index_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The name of the clustered index. By default it is `_id_`. Changing it recreates the collection.",
+			},
+			"expire_after_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "The number of seconds after which documents are deleted, measured from the date in their _id. " +
+					"Documents whose _id is not a date never expire. By default no document expires.",
+			},
+			"retry": retryResourceAttribute(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceClusteredCollection) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := types.ClusteredCollection{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	err := r.client.Resource().ClusteredCollection().Create(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create clustered collection", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceClusteredCollection) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := types.ClusteredCollection{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	state, exist, err := r.client.Resource().ClusteredCollection().Read(apiCtx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read clustered collection", err.Error())
+		return
+	}
+
+	if !exist {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceClusteredCollection) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := types.ClusteredCollection{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	err := r.client.Resource().ClusteredCollection().Update(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update clustered collection", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceClusteredCollection) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := types.ClusteredCollection{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.Resource().ClusteredCollection().Delete(apiCtx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete clustered collection", err.Error())
+		return
+	}
+}
+
+// ImportState imports the collection by the <database>.<collection> namespace.
+func (r *resourceClusteredCollection) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	state, err := r.client.Resource().ClusteredCollection().ImportState(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import clustered collection", err.Error())
+		return
+	}
+
+	state.Timeouts = nullTimeouts(resp.State)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceClusteredCollection) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(interfaces.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected interfaces.Client, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func testClusteredCollection() types.ClusteredCollection {
+	expire := int64(3600)
+
+	return types.ClusteredCollection{
+		Database:           "app",
+		Name:               "sessions",
+		ExpireAfterSeconds: &expire,
+	}
+}
+
+func TestResourceClusteredCollectionCreate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceClusteredCollection, client)
+
+	collection := testClusteredCollection()
+	collection.Timeouts = testTimeouts(s, nil)
+	resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+	r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, collection)}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	got, ok := client.GetClusteredCollection("app.sessions")
+	if !ok || got.ExpireAfterSeconds == nil || *got.ExpireAfterSeconds != 3600 {
+		t.Fatalf("expected collection %+v, got %+v", collection, got)
+	}
+
+	if !client.HasDatabase("app") {
+		t.Fatal("expected the database of the collection to be created")
+	}
+}
+
+func TestResourceClusteredCollectionCreateExisting(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceClusteredCollection, client)
+
+	client.SetClusteredCollection(testClusteredCollection())
+
+	collection := testClusteredCollection()
+	collection.Timeouts = testTimeouts(s, nil)
+	resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+	r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, collection)}, resp)
+
+	requireErrorDiag(t, resp.Diagnostics, "Failed to create clustered collection")
+}
+
+func TestResourceClusteredCollectionRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceClusteredCollection, client)
+
+	changed := testClusteredCollection()
+	changed.ExpireAfterSeconds = nil
+	client.SetClusteredCollection(changed)
+
+	collection := testClusteredCollection()
+	collection.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, collection)
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.ClusteredCollection
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.ExpireAfterSeconds != nil {
+		t.Fatalf("expected no expiration in state, got %d", *got.ExpireAfterSeconds)
+	}
+}
+
+func TestResourceClusteredCollectionReadRemoved(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceClusteredCollection, client)
+
+	collection := testClusteredCollection()
+	collection.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, collection)
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if !resp.State.Raw.IsNull() {
+		t.Fatal("expected the dropped collection to be removed from state")
+	}
+}
+
+func TestResourceClusteredCollectionUpdate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceClusteredCollection, client)
+
+	client.SetClusteredCollection(testClusteredCollection())
+
+	state := testClusteredCollection()
+	state.Timeouts = testTimeouts(s, nil)
+
+	expire := int64(60)
+	plan := state
+	plan.ExpireAfterSeconds = &expire
+
+	resp := &resource.UpdateResponse{State: newTestState(t, s, state)}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: newTestPlan(t, s, plan), State: newTestState(t, s, state)}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if got, _ := client.GetClusteredCollection("app.sessions"); got.ExpireAfterSeconds == nil || *got.ExpireAfterSeconds != 60 {
+		t.Fatalf("expected expiration after 60 seconds, got %+v", got)
+	}
+}
+
+func TestResourceClusteredCollectionDelete(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceClusteredCollection, client)
+
+	client.SetClusteredCollection(testClusteredCollection())
+
+	collection := testClusteredCollection()
+	collection.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, collection)
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if _, ok := client.GetClusteredCollection("app.sessions"); ok {
+		t.Fatal("expected the collection to be dropped")
+	}
+}
+
+func TestResourceClusteredCollectionImportState(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceClusteredCollection, client)
+
+	client.SetClusteredCollection(testClusteredCollection())
+
+	resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "app.sessions"}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.ClusteredCollection
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Database != "app" || got.Name != "sessions" || got.ExpireAfterSeconds == nil || *got.ExpireAfterSeconds != 3600 {
+		t.Fatalf("expected collection %+v in state, got %+v", testClusteredCollection(), got)
+	}
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-mongodb/internal/mongoclient/interfaces"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ datasource.DataSource              = &dataSourceCollections{}
+	_ datasource.DataSourceWithConfigure = &dataSourceCollections{}
+)
+
+func DataSourceCollections() datasource.DataSource {
+	return &dataSourceCollections{}
+}
+
+type dataSourceCollections struct {
+	client interfaces.Client
+}
+
+func (d *dataSourceCollections) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collections"
+}
+
+func (d *dataSourceCollections) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the collections and views of a database without the system collections, optionally with their statistics.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The database of the collections.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "A regular expression the collection names must match, e.g. `^orders_`. It is evaluated by MongoDB with the PCRE syntax of the `$regex` operator.",
+			},
+			"statistics": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the statistics of every collection are returned. They are read with one $collStats per collection.",
+			},
+			"collections": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The collections ordered by name, empty if the database does not exist.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the collection.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the collection: `collection`, `view` or `timeseries`.",
+						},
+						"options": schema.StringAttribute{
+							Computed:    true,
+							Description: "The options of the collection without the validator as a JSON document, e.g. the source and pipeline of a view.",
+						},
+						"validator": schema.StringAttribute{
+							Computed:    true,
+							Description: "The validator of the collection as a JSON document, if it has one.",
+						},
+						"stats": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "The statistics of the collection, set only if `statistics` is set. Views have none.",
+							Attributes: map[string]schema.Attribute{
+								"count": schema.Int64Attribute{
+									Computed:    true,
+									Description: "The number of documents.",
+								},
+								"size": schema.Int64Attribute{
+									Computed:    true,
+									Description: "The uncompressed size of the documents in bytes.",
+								},
+								"storage_size": schema.Int64Attribute{
+									Computed:    true,
+									Description: "The size allocated to the documents in bytes.",
+								},
+								"total_index_size": schema.Int64Attribute{
+									Computed:    true,
+									Description: "The size allocated to the indexes in bytes.",
+								},
+								"indexes": schema.Int64Attribute{
+									Computed:    true,
+									Description: "The number of indexes.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceCollections) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	config := types.Collections{}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := d.client.DataSource().Collection().Read(ctx, config)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read collections", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (d *dataSourceCollections) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(interfaces.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected interfaces.Client, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestDataSourceCollectionsRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+	client.AddCollection("app", "orders")
+	client.AddCollection("app", "customers")
+	client.AddCollection("reporting", "orders")
+	client.SetView(types.View{Database: "app", Name: "active_orders", ViewOn: "orders", Pipeline: "[]"})
+	client.SetCollectionStats("app.orders", types.CollectionStats{Count: 10, Size: 2048, Indexes: 1})
+
+	d, state := newTestDataSource(t, DataSourceCollections, client)
+	resp := &datasource.ReadResponse{State: state}
+
+	regex, statistics := "orders", true
+	config := newTestConfig(t, state, types.Collections{Database: "app", NameRegex: &regex, Statistics: &statistics})
+
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.Collections
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if len(got.Collections) != 2 || got.Collections[0].Name != "active_orders" || got.Collections[1].Name != "orders" {
+		t.Fatalf("expected active_orders and orders, got %+v", got.Collections)
+	}
+
+	if got.Collections[0].Type != "view" || got.Collections[0].Stats != nil {
+		t.Fatalf("expected a view without statistics, got %+v", got.Collections[0])
+	}
+
+	if stats := got.Collections[1].Stats; stats == nil || stats.Count != 10 || stats.Size != 2048 {
+		t.Fatalf("expected the statistics of orders, got %+v", stats)
+	}
+}
+
+func TestDataSourceCollectionsReadError(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	d, state := newTestDataSource(t, DataSourceCollections, client)
+	resp := &datasource.ReadResponse{State: state}
+
+	regex := "("
+	d.Read(ctx, datasource.ReadRequest{Config: newTestConfig(t, state, types.Collections{Database: "app", NameRegex: &regex})}, resp)
+
+	requireErrorDiag(t, resp.Diagnostics, "Failed to read collections")
+}
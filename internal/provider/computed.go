@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	tfprotoTypes "github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// nullUnknown returns the plan with the unknown top-level attribute set to null. Computed attributes are unknown
+// in the plan of a new resource, which can't be read into the Go types of the models.
+func nullUnknown(plan tfsdk.Plan, name string) (tfsdk.Plan, error) {
+	attr := tfprotoTypes.NewAttributePath().WithAttributeName(name)
+
+	raw, err := tfprotoTypes.Transform(plan.Raw, func(p *tfprotoTypes.AttributePath, v tfprotoTypes.Value) (tfprotoTypes.Value, error) {
+		if p.Equal(attr) && !v.IsKnown() {
+			return tfprotoTypes.NewValue(v.Type(), nil), nil
+		}
+
+		return v, nil
+	})
+
+	plan.Raw = raw
+
+	return plan, err
+}
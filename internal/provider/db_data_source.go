@@ -5,11 +5,12 @@ import (
 	"fmt"
 
 	"terraform-provider-mongodb/internal/mongoclient/interfaces"
+	"terraform-provider-mongodb/internal/mongoclient/types"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	tfypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 var (
@@ -31,28 +32,84 @@ func (d *dataSourceDatabases) Metadata(_ context.Context, req datasource.Metadat
 
 func (d *dataSourceDatabases) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Returns the databases without the admin, config and local databases, optionally with their statistics.",
 		Attributes: map[string]schema.Attribute{
-			"databases": schema.ListAttribute{
+			"name_regex": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "A regular expression the database names must match, e.g. `^app_`. It is evaluated by MongoDB with the PCRE syntax of the `$regex` operator.",
+			},
+			"statistics": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the statistics of every database are returned. They are read with one dbStats per database.",
+			},
+			"databases": schema.ListNestedAttribute{
 				Computed: true,
-				ElementType: tfypes.ObjectType{
-					AttrTypes: map[string]attr.Type{
-						"name": tfypes.StringType,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the database.",
+						},
+						"stats": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "The statistics of the database, set only if `statistics` is set.",
+							Attributes: map[string]schema.Attribute{
+								"size_on_disk": schema.Int64Attribute{
+									Computed:    true,
+									Description: "The size of the database files on disk in bytes.",
+								},
+								"data_size": schema.Int64Attribute{
+									Computed:    true,
+									Description: "The uncompressed size of the documents in bytes.",
+								},
+								"storage_size": schema.Int64Attribute{
+									Computed:    true,
+									Description: "The size allocated to the documents in bytes.",
+								},
+								"index_size": schema.Int64Attribute{
+									Computed:    true,
+									Description: "The size allocated to the indexes in bytes.",
+								},
+								"collections": schema.Int64Attribute{
+									Computed:    true,
+									Description: "The number of collections and views.",
+								},
+								"objects": schema.Int64Attribute{
+									Computed:    true,
+									Description: "The number of documents.",
+								},
+								"empty": schema.BoolAttribute{
+									Computed:    true,
+									Description: "Whether the database holds no data.",
+								},
+							},
+						},
 					},
 				},
-				Description: "List of databases with names",
+				Description: "The databases ordered by name.",
 			},
 		},
 	}
 }
 
-func (d *dataSourceDatabases) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
-	state, err := d.client.DataSource().Database().Read(ctx)
+func (d *dataSourceDatabases) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	config := types.DataSourceDatabases{}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := d.client.DataSource().Database().Read(ctx, config)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read databases", err.Error())
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (d *dataSourceDatabases) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/acctest"
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSourceDatabasesRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+	client.AddDatabase("admin")
+	client.AddDatabase("reporting")
+	client.AddDatabase("app")
+
+	d, state := newTestDataSource(t, DataSourceDatabases, client)
+	resp := &datasource.ReadResponse{State: state}
+
+	d.Read(ctx, datasource.ReadRequest{Config: newTestConfig(t, state, types.DataSourceDatabases{})}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.DataSourceDatabases
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if len(got.Databases) != 2 || got.Databases[0].Name != "app" || got.Databases[1].Name != "reporting" {
+		t.Fatalf("expected databases app and reporting, got %v", got.Databases)
+	}
+
+	if got.Databases[0].Stats != nil {
+		t.Fatalf("expected no statistics without statistics set, got %+v", got.Databases[0].Stats)
+	}
+}
+
+func TestDataSourceDatabasesReadStatistics(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+	client.AddCollection("app_eu", "orders")
+	client.AddCollection("app_us", "orders")
+	client.AddDatabase("reporting")
+	client.SetDatabaseStats("app_eu", types.DatabaseStats{SizeOnDisk: 8192, DataSize: 2048, Collections: 1, Objects: 10})
+
+	d, state := newTestDataSource(t, DataSourceDatabases, client)
+	resp := &datasource.ReadResponse{State: state}
+
+	regex, statistics := "^app_", true
+	config := newTestConfig(t, state, types.DataSourceDatabases{NameRegex: &regex, Statistics: &statistics})
+
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.DataSourceDatabases
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if len(got.Databases) != 2 || got.Databases[0].Name != "app_eu" || got.Databases[1].Name != "app_us" {
+		t.Fatalf("expected databases app_eu and app_us, got %v", got.Databases)
+	}
+
+	if stats := got.Databases[0].Stats; stats == nil || stats.SizeOnDisk != 8192 || stats.Objects != 10 {
+		t.Fatalf("expected the statistics of app_eu, got %+v", stats)
+	}
+
+	if got.NameRegex == nil || *got.NameRegex != regex {
+		t.Fatalf("expected the name regex to be kept in state, got %v", got.NameRegex)
+	}
+}
+
+func TestDataSourceDatabasesReadNoMatch(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+	client.AddDatabase("reporting")
+
+	d, state := newTestDataSource(t, DataSourceDatabases, client)
+	resp := &datasource.ReadResponse{State: state}
+
+	regex := "^app_"
+	d.Read(ctx, datasource.ReadRequest{Config: newTestConfig(t, state, types.DataSourceDatabases{NameRegex: &regex})}, resp)
+
+	requireErrorDiag(t, resp.Diagnostics, "Failed to read databases")
+}
+
+func TestAccDataSourceDatabases(t *testing.T) {
+	acctest.PreCheck(t)
+
+	cluster := acctest.StartStandalone(t)
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: cluster.ProviderConfig() + testAccResourceDatabaseConfig("acc_database") + `
+data "mongodb_databases" "test" {
+  depends_on = [mongodb_database.test]
+}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.mongodb_databases.test", "databases.#", "1"),
+					tfresource.TestCheckResourceAttr("data.mongodb_databases.test", "databases.0.name", "acc_database"),
+				),
+			},
+		},
+	})
+}
@@ -8,16 +8,24 @@ import (
 	"terraform-provider-mongodb/internal/mongoclient/types"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ resource.Resource                = &resourceDatabase{}
-	_ resource.ResourceWithConfigure   = &resourceDatabase{}
-	_ resource.ResourceWithImportState = &resourceDatabase{}
+	_ resource.Resource                   = &resourceDatabase{}
+	_ resource.ResourceWithConfigure      = &resourceDatabase{}
+	_ resource.ResourceWithImportState    = &resourceDatabase{}
+	_ resource.ResourceWithValidateConfig = &resourceDatabase{}
 )
 
 func ResourceDatabase() resource.Resource {
@@ -34,6 +42,8 @@ func (r *resourceDatabase) Metadata(_ context.Context, req resource.MetadataRequ
 
 func (r *resourceDatabase) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Manages a database. MongoDB creates a database with its first collection, so the database is created " +
+			"with the declared collections or, without them, with a document in a marker collection.",
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				Required: true,
@@ -42,15 +52,87 @@ func (r *resourceDatabase) Schema(ctx context.Context, _ resource.SchemaRequest,
 				},
 				Description: "Database name to create.",
 			},
+			"collections": schema.SetAttribute{
+				Optional:    true,
+				ElementType: tftypes.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				Description: "The collections created with the database. Added collections are created in place, " +
+					"removed collections are kept, and collections created outside Terraform are ignored.",
+			},
+			"marker_collection": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(types.DefaultDatabaseMarkerCollection),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The collection the marker document is inserted into. Default is `created_by_terraform`. " +
+					"Changing it does not rename an existing marker collection.",
+			},
+			"marker_mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(types.DatabaseMarkerModeKeep),
+				Validators: []validator.String{
+					stringvalidator.OneOf(types.DatabaseMarkerModes...),
+				},
+				Description: "When the marker collection is created: `keep` always creates and keeps it, `remove` creates it " +
+					"only without declared collections and drops it on the next apply once the database has another collection, " +
+					"`none` never creates it and requires declared collections. Default is `keep`.",
+			},
+			"marker_present": schema.BoolAttribute{
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "Whether the `remove` marker mode left the marker collection next to another collection. " +
+					"A refresh reports it and the next apply drops the marker. Always false in the other modes.",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Description: "Whether destroying the database is refused, also when it is replaced. " +
+					"Set it to false and apply before destroying the database.",
+			},
+			"drop_only_if_empty": schema.BoolAttribute{
+				Optional: true,
+				Description: "Whether the database is dropped only if its collections hold no documents besides the marker. " +
+					"A database holding data is kept and the destroy fails.",
+			},
+			"retry": retryResourceAttribute(),
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
 				Read:   true,
+				Update: true,
 				Delete: true,
 			}),
 		},
 	}
 }
 
+// ValidateConfig rejects the none marker mode without declared collections, as MongoDB does not create empty databases.
+func (r *resourceDatabase) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var mode tftypes.String
+	var collections tftypes.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("marker_mode"), &mode)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("collections"), &collections)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if mode.ValueString() != types.DatabaseMarkerModeNone || collections.IsUnknown() {
+		return
+	}
+
+	if len(collections.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("collections"),
+			"Missing database collections",
+			`marker_mode = "none" never creates the marker collection, so the database needs at least one collection in collections.`,
+		)
+	}
+}
+
 func (r *resourceDatabase) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	plan := types.Database{}
 
@@ -94,9 +176,10 @@ func (r *resourceDatabase) Read(ctx context.Context, req resource.ReadRequest, r
 	apiCtx, cancel := context.WithTimeout(ctx, readTimeout)
 	defer cancel()
 
-	exist, err := r.client.Resource().Database().Exists(apiCtx, state)
+	// A marker the remove mode left is reported in marker_present, the plan sets it to false so the update drops it
+	state, exist, err := r.client.Resource().Database().Read(apiCtx, state)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to check database existence", err.Error())
+		resp.Diagnostics.AddError("Failed to read database", err.Error())
 		return
 	}
 
@@ -108,11 +191,32 @@ func (r *resourceDatabase) Read(ctx context.Context, req resource.ReadRequest, r
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
-func (r *resourceDatabase) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddWarning(
-		"Update not supported",
-		"The update method is not implemented for this resource, and any changes will require resource recreation.",
-	)
+// Update creates the added collections and drops the marker collection in the remove mode,
+// a new name requires resource recreation.
+func (r *resourceDatabase) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := types.Database{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	err := r.client.Resource().Database().Update(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update database", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *resourceDatabase) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -123,6 +227,15 @@ func (r *resourceDatabase) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	if state.IsDeletionProtected() {
+		resp.Diagnostics.AddError(
+			"Database is protected from deletion",
+			fmt.Sprintf("Database %s has deletion_protection set. Set it to false and apply before destroying or replacing the database.", state.Name),
+		)
+
+		return
+	}
+
 	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -148,6 +261,11 @@ func (r *resourceDatabase) ImportState(ctx context.Context, req resource.ImportS
 		return
 	}
 
+	// The marker of an imported database is unknown, keep it as is
+	marker, mode, present := types.DefaultDatabaseMarkerCollection, types.DatabaseMarkerModeKeep, false
+	state.MarkerCollection, state.MarkerMode, state.MarkerPresent = &marker, &mode, &present
+	state.Timeouts = nullTimeouts(resp.State)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
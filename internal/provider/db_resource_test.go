@@ -0,0 +1,492 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"terraform-provider-mongodb/internal/acctest"
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestResourceDatabaseCreate(t *testing.T) {
+	tests := []struct {
+		name     string
+		database string
+		existing []string
+		inject   error
+		wantErr  bool
+	}{
+		{name: "creates database", database: "app"},
+		{name: "database already exists", database: "app", existing: []string{"app"}, wantErr: true},
+		{name: "default database", database: "admin", wantErr: true},
+		{name: "client error", database: "app", inject: errors.New("not authorized"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+			for _, name := range tt.existing {
+				client.AddDatabase(name)
+			}
+			client.InjectError(fake.MethodResourceDatabaseCreate, tt.inject)
+
+			r, s := newTestResource(t, ResourceDatabase, client)
+
+			req := resource.CreateRequest{
+				Plan: newTestPlan(t, s, types.Database{Name: tt.database, Timeouts: testTimeouts(s, nil)}),
+			}
+			resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+			r.Create(ctx, req, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to create database")
+				return
+			}
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			if !client.HasDatabase(tt.database) {
+				t.Fatalf("database %s was not created", tt.database)
+			}
+
+			var got types.Database
+			requireNoDiags(t, resp.State.Get(ctx, &got))
+
+			if got.Name != tt.database {
+				t.Fatalf("expected name %q in state, got %q", tt.database, got.Name)
+			}
+		})
+	}
+}
+
+func TestResourceDatabaseCreateCollections(t *testing.T) {
+	tests := []struct {
+		name            string
+		mode            string
+		collections     []string
+		wantCollections []string
+		wantErr         bool
+	}{
+		{name: "keep marker", mode: types.DatabaseMarkerModeKeep, collections: []string{"orders"}, wantCollections: []string{"_marker", "orders"}},
+		{name: "remove marker", mode: types.DatabaseMarkerModeRemove, collections: []string{"orders"}, wantCollections: []string{"orders"}},
+		{name: "marker without collections", mode: types.DatabaseMarkerModeRemove, wantCollections: []string{"_marker"}},
+		{name: "no marker", mode: types.DatabaseMarkerModeNone, collections: []string{"orders"}, wantCollections: []string{"orders"}},
+		{name: "no marker without collections", mode: types.DatabaseMarkerModeNone, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+
+			r, s := newTestResource(t, ResourceDatabase, client)
+
+			marker := "_marker"
+			req := resource.CreateRequest{
+				Plan: newTestPlan(t, s, types.Database{
+					Name:             "app",
+					Collections:      tt.collections,
+					MarkerCollection: &marker,
+					MarkerMode:       &tt.mode,
+					Timeouts:         testTimeouts(s, nil),
+				}),
+			}
+			resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+			r.Create(ctx, req, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to create database")
+				return
+			}
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			if got := client.DatabaseCollections("app"); fmt.Sprint(got) != fmt.Sprint(tt.wantCollections) {
+				t.Fatalf("expected collections %v, got %v", tt.wantCollections, got)
+			}
+		})
+	}
+}
+
+func TestResourceDatabaseCreateTimeout(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+	client.SetLatency(time.Second)
+
+	r, s := newTestResource(t, ResourceDatabase, client)
+
+	req := resource.CreateRequest{
+		Plan: newTestPlan(t, s, types.Database{Name: "app", Timeouts: testTimeouts(s, map[string]string{"create": "10ms"})}),
+	}
+	resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+	r.Create(ctx, req, resp)
+
+	requireErrorDiag(t, resp.Diagnostics, "Failed to create database")
+
+	if client.HasDatabase("app") {
+		t.Fatal("database was created after the timeout")
+	}
+}
+
+func TestResourceDatabaseRead(t *testing.T) {
+	tests := []struct {
+		name        string
+		existing    bool
+		inject      error
+		wantRemoved bool
+		wantErr     bool
+	}{
+		{name: "database exists", existing: true},
+		{name: "database removed outside of terraform", wantRemoved: true},
+		{name: "client error", existing: true, inject: errors.New("connection refused"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+			if tt.existing {
+				client.AddDatabase("app")
+			}
+			client.InjectError(fake.MethodResourceDatabaseRead, tt.inject)
+
+			r, s := newTestResource(t, ResourceDatabase, client)
+
+			state := newTestState(t, s, types.Database{Name: "app", Timeouts: testTimeouts(s, nil)})
+			resp := &resource.ReadResponse{State: state}
+
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to read database")
+				return
+			}
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			if resp.State.Raw.IsNull() != tt.wantRemoved {
+				t.Fatalf("expected resource removed from state: %t, got: %t", tt.wantRemoved, resp.State.Raw.IsNull())
+			}
+		})
+	}
+}
+
+func TestResourceDatabaseReadMarkerPresent(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+	client.AddCollection("app", types.DefaultDatabaseMarkerCollection)
+	client.AddCollection("app", "orders")
+
+	r, s := newTestResource(t, ResourceDatabase, client)
+
+	mode := types.DatabaseMarkerModeRemove
+	state := newTestState(t, s, types.Database{Name: "app", MarkerMode: &mode, Timeouts: testTimeouts(s, nil)})
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if got := client.DatabaseCollections("app"); fmt.Sprint(got) != "[created_by_terraform orders]" {
+		t.Fatalf("expected read to keep the collections, got %v", got)
+	}
+
+	var got types.Database
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Mode() != types.DatabaseMarkerModeRemove {
+		t.Fatalf("expected the configured marker mode %s, got %s", types.DatabaseMarkerModeRemove, got.Mode())
+	}
+
+	if !got.IsMarkerPresent() {
+		t.Fatal("expected the remaining marker reported in marker_present")
+	}
+
+	// The default of marker_present plans false against true in state, so the plan has a change which drops the marker
+	present := false
+	plan := newTestPlan(t, s, types.Database{Name: "app", MarkerMode: &mode, MarkerPresent: &present, Timeouts: testTimeouts(s, nil)})
+	updateResp := &resource.UpdateResponse{State: resp.State}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: resp.State}, updateResp)
+
+	requireNoDiags(t, updateResp.Diagnostics)
+
+	if got := client.DatabaseCollections("app"); fmt.Sprint(got) != "[orders]" {
+		t.Fatalf("expected the update to drop the marker, got collections %v", got)
+	}
+
+	readResp := &resource.ReadResponse{State: updateResp.State}
+
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, readResp)
+
+	requireNoDiags(t, readResp.Diagnostics)
+	requireNoDiags(t, readResp.State.Get(ctx, &got))
+
+	if got.IsMarkerPresent() {
+		t.Fatal("expected no marker reported after the update")
+	}
+}
+
+func TestResourceDatabaseValidateConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		mode        string
+		collections []string
+		wantErr     bool
+	}{
+		{name: "none with collections", mode: types.DatabaseMarkerModeNone, collections: []string{"orders"}},
+		{name: "none without collections", mode: types.DatabaseMarkerModeNone, wantErr: true},
+		{name: "remove without collections", mode: types.DatabaseMarkerModeRemove},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r, s := newTestResource(t, ResourceDatabase, fake.New())
+
+			config := newTestConfig(t, emptyTestState(s), types.Database{
+				Name:        "app",
+				Collections: tt.collections,
+				MarkerMode:  &tt.mode,
+				Timeouts:    testTimeouts(s, nil),
+			})
+			resp := &resource.ValidateConfigResponse{}
+
+			r.(resource.ResourceWithValidateConfig).ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Missing database collections")
+				return
+			}
+
+			requireNoDiags(t, resp.Diagnostics)
+		})
+	}
+}
+
+func TestResourceDatabaseUpdateCollections(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+	client.AddCollection("app", "orders")
+
+	r, s := newTestResource(t, ResourceDatabase, client)
+
+	state := newTestState(t, s, types.Database{Name: "app", Collections: []string{"orders"}, Timeouts: testTimeouts(s, nil)})
+	plan := newTestPlan(t, s, types.Database{Name: "app", Collections: []string{"customers"}, Timeouts: testTimeouts(s, nil)})
+	resp := &resource.UpdateResponse{State: state}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if got := client.DatabaseCollections("app"); fmt.Sprint(got) != "[customers orders]" {
+		t.Fatalf("expected customers to be created and orders kept, got collections %v", got)
+	}
+}
+
+func TestResourceDatabaseUpdateRetry(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+	client.AddDatabase("app")
+
+	r, s := newTestResource(t, ResourceDatabase, client)
+
+	attempts := int64(1)
+	state := newTestState(t, s, types.Database{Name: "app", Timeouts: testTimeouts(s, nil)})
+	plan := newTestPlan(t, s, types.Database{Name: "app", Retry: &types.Retry{Attempts: &attempts}, Timeouts: testTimeouts(s, nil)})
+	resp := &resource.UpdateResponse{State: state}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.Database
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Retry == nil || got.Retry.Attempts == nil || *got.Retry.Attempts != attempts {
+		t.Fatalf("expected retry attempts %d in state, got %+v", attempts, got.Retry)
+	}
+}
+
+func TestResourceDatabaseDelete(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+	client.AddDatabase("app")
+
+	r, s := newTestResource(t, ResourceDatabase, client)
+
+	state := newTestState(t, s, types.Database{Name: "app", Timeouts: testTimeouts(s, nil)})
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if client.HasDatabase("app") {
+		t.Fatal("database was not deleted")
+	}
+}
+
+func TestResourceDatabaseDeleteProtected(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+	client.AddDatabase("app")
+
+	r, s := newTestResource(t, ResourceDatabase, client)
+
+	protected := true
+	state := newTestState(t, s, types.Database{Name: "app", DeletionProtection: &protected, Timeouts: testTimeouts(s, nil)})
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	requireErrorDiag(t, resp.Diagnostics, "Database is protected from deletion")
+
+	if !client.HasDatabase("app") {
+		t.Fatal("expected the protected database to be kept")
+	}
+
+	if calls := client.Calls(fake.MethodResourceDatabaseDelete); calls != 0 {
+		t.Fatalf("expected no delete call, got %d", calls)
+	}
+}
+
+func TestResourceDatabaseDeleteOnlyIfEmpty(t *testing.T) {
+	tests := []struct {
+		name        string
+		collections []string
+		wantErr     bool
+	}{
+		{name: "only marker", collections: []string{types.DefaultDatabaseMarkerCollection}},
+		{name: "collections", collections: []string{types.DefaultDatabaseMarkerCollection, "orders"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+			for _, name := range tt.collections {
+				client.AddCollection("app", name)
+			}
+
+			r, s := newTestResource(t, ResourceDatabase, client)
+
+			onlyIfEmpty := true
+			state := newTestState(t, s, types.Database{Name: "app", DropOnlyIfEmpty: &onlyIfEmpty, Timeouts: testTimeouts(s, nil)})
+			resp := &resource.DeleteResponse{State: state}
+
+			r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to delete database")
+			} else {
+				requireNoDiags(t, resp.Diagnostics)
+			}
+
+			if client.HasDatabase("app") != tt.wantErr {
+				t.Fatalf("expected database kept: %t", tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResourceDatabaseImportState(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		existing bool
+		wantErr  bool
+	}{
+		{name: "imports database", id: "app", existing: true},
+		{name: "database does not exist", id: "app", wantErr: true},
+		{name: "default database", id: "local", existing: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+			if tt.existing {
+				client.AddDatabase(tt.id)
+			}
+
+			r, s := newTestResource(t, ResourceDatabase, client)
+
+			resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+			r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to import database")
+				return
+			}
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			var got types.Database
+			requireNoDiags(t, resp.State.Get(ctx, &got))
+
+			if got.Name != tt.id {
+				t.Fatalf("expected name %q in state, got %q", tt.id, got.Name)
+			}
+
+			if got.Marker() != types.DefaultDatabaseMarkerCollection || got.MarkerMode == nil || *got.MarkerMode != types.DatabaseMarkerModeKeep {
+				t.Fatalf("expected the default marker in state, got %v and %v", got.MarkerCollection, got.MarkerMode)
+			}
+		})
+	}
+}
+
+func TestAccResourceDatabase(t *testing.T) {
+	acctest.PreCheck(t)
+
+	cluster := acctest.StartStandalone(t)
+	config := cluster.ProviderConfig() + testAccResourceDatabaseConfig("acc_database")
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: config,
+				Check:  tfresource.TestCheckResourceAttr("mongodb_database.test", "name", "acc_database"),
+			},
+			{
+				ResourceName:                         "mongodb_database.test",
+				ImportState:                          true,
+				ImportStateId:                        "acc_database",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			{
+				// The database is dropped outside of Terraform and must be planned for creation again
+				PreConfig: func() {
+					if err := cluster.Client(t).Database("acc_database").Drop(context.Background()); err != nil {
+						t.Fatalf("failed to drop database: %s", err)
+					}
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccResourceDatabaseConfig(name string) string {
+	return fmt.Sprintf(`
+resource "mongodb_database" "test" {
+  name = %q
+}
+`, name)
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-mongodb/internal/mongoclient/interfaces"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ resource.Resource                = &resourceDefaultRWConcern{}
+	_ resource.ResourceWithConfigure   = &resourceDefaultRWConcern{}
+	_ resource.ResourceWithImportState = &resourceDefaultRWConcern{}
+)
+
+func ResourceDefaultRWConcern() resource.Resource {
+	return &resourceDefaultRWConcern{}
+}
+
+type resourceDefaultRWConcern struct {
+	client interfaces.Client
+}
+
+func (r *resourceDefaultRWConcern) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_rw_concern"
+}
+
+func (r *resourceDefaultRWConcern) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the cluster-wide default read and write concern with setDefaultRWConcern, on a replica set " +
+			"or through a mongos. There is one default per cluster, so declare a single resource. MongoDB can't unset the default " +
+			"write concern once it is set, so deleting the resource resets it to majority and unsets the default read concern.",
+		Attributes: map[string]schema.Attribute{
+			"default_write_concern": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "The default write concern of operations without an explicit write concern.",
+				Attributes: map[string]schema.Attribute{
+					"w": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						Description: "The number of members which must acknowledge a write, majority or the name of a custom write concern.",
+					},
+					"j": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether writes must be written to the on-disk journal before they are acknowledged.",
+					},
+					"wtimeout": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+						Description: "The time limit in milliseconds for the write concern. By default there is no limit.",
+					},
+				},
+			},
+			"default_read_concern": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The default read concern of operations without an explicit read concern. By default it is the implicit default of the server.",
+				Attributes: map[string]schema.Attribute{
+					"level": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("local", "available", "majority"),
+						},
+						Description: "The read concern level: local, available or majority.",
+					},
+				},
+			},
+			"retry": retryResourceAttribute(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceDefaultRWConcern) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := types.DefaultRWConcern{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	err := r.client.Resource().DefaultRWConcern().Create(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create default read/write concern", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceDefaultRWConcern) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := types.DefaultRWConcern{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	state, err := r.client.Resource().DefaultRWConcern().Read(apiCtx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read default read/write concern", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceDefaultRWConcern) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := types.DefaultRWConcern{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	err := r.client.Resource().DefaultRWConcern().Update(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update default read/write concern", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceDefaultRWConcern) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := types.DefaultRWConcern{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.Resource().DefaultRWConcern().Delete(apiCtx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete default read/write concern", err.Error())
+		return
+	}
+}
+
+// ImportState imports the defaults of the cluster, the import ID is ignored.
+func (r *resourceDefaultRWConcern) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	state, err := r.client.Resource().DefaultRWConcern().ImportState(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import default read/write concern", err.Error())
+		return
+	}
+
+	state.Timeouts = nullTimeouts(resp.State)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceDefaultRWConcern) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(interfaces.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected interfaces.Client, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
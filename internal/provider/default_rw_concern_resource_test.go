@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func testDefaultRWConcern() types.DefaultRWConcern {
+	j := true
+	wtimeout := int64(5000)
+
+	return types.DefaultRWConcern{
+		WriteConcern: types.WriteConcern{W: types.MajorityWriteConcern().W, J: &j, WTimeout: &wtimeout},
+		ReadConcern:  &types.ReadConcern{Level: "majority"},
+	}
+}
+
+func TestResourceDefaultRWConcernCreate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceDefaultRWConcern, client)
+
+	concern := testDefaultRWConcern()
+	concern.Timeouts = testTimeouts(s, nil)
+	resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+	r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, concern)}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	got := client.GetDefaultRWConcern()
+	if got.WriteConcern.WTimeout == nil || *got.WriteConcern.WTimeout != 5000 || got.ReadConcern == nil || got.ReadConcern.Level != "majority" {
+		t.Fatalf("expected defaults %+v, got %+v", concern, got)
+	}
+}
+
+func TestResourceDefaultRWConcernRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceDefaultRWConcern, client)
+
+	w := "1"
+	client.SetDefaultRWConcern(types.DefaultRWConcern{WriteConcern: types.WriteConcern{W: &w}})
+
+	concern := testDefaultRWConcern()
+	concern.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, concern)
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.DefaultRWConcern
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.WriteConcern.W == nil || *got.WriteConcern.W != "1" || got.WriteConcern.J != nil || got.ReadConcern != nil {
+		t.Fatalf("expected drift in state, got %+v", got)
+	}
+}
+
+func TestResourceDefaultRWConcernReadUnset(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceDefaultRWConcern, client)
+
+	client.SetDefaultRWConcern(types.DefaultRWConcern{})
+
+	concern := testDefaultRWConcern()
+	concern.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, concern)
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.DefaultRWConcern
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.WriteConcern.W != nil {
+		t.Fatalf("expected null w in state without a default write concern, got %s", *got.WriteConcern.W)
+	}
+}
+
+func TestResourceDefaultRWConcernDelete(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceDefaultRWConcern, client)
+
+	concern := testDefaultRWConcern()
+	w := "2"
+	concern.WriteConcern.W = &w
+	client.SetDefaultRWConcern(concern)
+
+	concern.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, concern)
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	got := client.GetDefaultRWConcern()
+	if got.WriteConcern.W == nil || *got.WriteConcern.W != types.WriteConcernMajority || got.WriteConcern.WTimeout != nil || got.ReadConcern != nil {
+		t.Fatalf("expected majority write concern without read concern, got %+v", got)
+	}
+}
+
+func TestResourceDefaultRWConcernImportState(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceDefaultRWConcern, client)
+
+	client.SetDefaultRWConcern(testDefaultRWConcern())
+
+	resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "default"}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.DefaultRWConcern
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.WriteConcern.W == nil || *got.WriteConcern.W != types.WriteConcernMajority || got.ReadConcern == nil || got.ReadConcern.Level != "majority" {
+		t.Fatalf("expected defaults %+v in state, got %+v", testDefaultRWConcern(), got)
+	}
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"terraform-provider-mongodb/internal/mongoclient/interfaces"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ resource.Resource                = &resourceFeatureCompatibilityVersion{}
+	_ resource.ResourceWithConfigure   = &resourceFeatureCompatibilityVersion{}
+	_ resource.ResourceWithImportState = &resourceFeatureCompatibilityVersion{}
+)
+
+func ResourceFeatureCompatibilityVersion() resource.Resource {
+	return &resourceFeatureCompatibilityVersion{}
+}
+
+type resourceFeatureCompatibilityVersion struct {
+	client interfaces.Client
+}
+
+func (r *resourceFeatureCompatibilityVersion) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_compatibility_version"
+}
+
+func (r *resourceFeatureCompatibilityVersion) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the feature compatibility version of a standalone server, a replica set or a sharded cluster through a mongos. " +
+			"After the version is set, every data-bearing replica set member is polled until it has completed the transition. " +
+			"Through a mongos the shard members are not polled, as they may not accept the credentials of the router: " +
+			"MongoDB sets the version on the primary of each shard and waits only for a majority of its members. " +
+			"There is one version per cluster, so declare a single resource. Deleting the resource keeps the version.",
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^\d+\.\d+$`),
+						"Version must be in the <major>.<minor> format, e.g. 6.0",
+					),
+				},
+				Description: "The feature compatibility version, e.g. 6.0.",
+			},
+			"allow_downgrade": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the version may be lowered. Downgrades are refused by default.",
+			},
+			"retry": retryResourceAttribute(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceFeatureCompatibilityVersion) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := types.FeatureCompatibilityVersion{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	err := r.client.Resource().FeatureCompatibilityVersion().Create(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create feature compatibility version", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceFeatureCompatibilityVersion) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := types.FeatureCompatibilityVersion{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	state, err := r.client.Resource().FeatureCompatibilityVersion().Read(apiCtx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read feature compatibility version", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceFeatureCompatibilityVersion) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := types.FeatureCompatibilityVersion{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	err := r.client.Resource().FeatureCompatibilityVersion().Update(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update feature compatibility version", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceFeatureCompatibilityVersion) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := types.FeatureCompatibilityVersion{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.Resource().FeatureCompatibilityVersion().Delete(apiCtx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete feature compatibility version", err.Error())
+		return
+	}
+}
+
+// ImportState imports the version of the cluster, the import ID is ignored.
+func (r *resourceFeatureCompatibilityVersion) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	state, err := r.client.Resource().FeatureCompatibilityVersion().ImportState(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import feature compatibility version", err.Error())
+		return
+	}
+
+	state.Timeouts = nullTimeouts(resp.State)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceFeatureCompatibilityVersion) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(interfaces.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected interfaces.Client, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestResourceFeatureCompatibilityVersionCreate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceFeatureCompatibilityVersion, client)
+
+	client.SetFeatureCompatibilityVersion("5.0")
+
+	version := types.FeatureCompatibilityVersion{Version: "6.0", Timeouts: testTimeouts(s, nil)}
+	resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+	r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, version)}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if got := client.GetFeatureCompatibilityVersion(); got != "6.0" {
+		t.Fatalf("expected version 6.0, got %s", got)
+	}
+}
+
+func TestResourceFeatureCompatibilityVersionUpdate(t *testing.T) {
+	allowDowngrade := true
+
+	tests := []struct {
+		name    string
+		plan    types.FeatureCompatibilityVersion
+		want    string
+		wantErr bool
+	}{
+		{name: "upgrade", plan: types.FeatureCompatibilityVersion{Version: "7.0"}, want: "7.0"},
+		{name: "downgrade is refused", plan: types.FeatureCompatibilityVersion{Version: "5.0"}, want: "6.0", wantErr: true},
+		{name: "downgrade is allowed", plan: types.FeatureCompatibilityVersion{Version: "5.0", AllowDowngrade: &allowDowngrade}, want: "5.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+
+			r, s := newTestResource(t, ResourceFeatureCompatibilityVersion, client)
+
+			state := types.FeatureCompatibilityVersion{Version: "6.0", Timeouts: testTimeouts(s, nil)}
+			plan := tt.plan
+			plan.Timeouts = testTimeouts(s, nil)
+
+			resp := &resource.UpdateResponse{State: newTestState(t, s, state)}
+
+			r.Update(ctx, resource.UpdateRequest{Plan: newTestPlan(t, s, plan), State: newTestState(t, s, state)}, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to update feature compatibility version")
+			} else {
+				requireNoDiags(t, resp.Diagnostics)
+			}
+
+			if got := client.GetFeatureCompatibilityVersion(); got != tt.want {
+				t.Fatalf("expected version %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestResourceFeatureCompatibilityVersionRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceFeatureCompatibilityVersion, client)
+
+	allowDowngrade := true
+	state := newTestState(t, s, types.FeatureCompatibilityVersion{Version: "7.0", AllowDowngrade: &allowDowngrade, Timeouts: testTimeouts(s, nil)})
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.FeatureCompatibilityVersion
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Version != fake.DefaultFeatureCompatibilityVersion || !got.IsDowngradeAllowed() {
+		t.Fatalf("expected drift to %s keeping allow_downgrade, got %+v", fake.DefaultFeatureCompatibilityVersion, got)
+	}
+}
+
+func TestResourceFeatureCompatibilityVersionDelete(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceFeatureCompatibilityVersion, client)
+
+	client.SetFeatureCompatibilityVersion("7.0")
+
+	state := newTestState(t, s, types.FeatureCompatibilityVersion{Version: "7.0", Timeouts: testTimeouts(s, nil)})
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if got := client.GetFeatureCompatibilityVersion(); got != "7.0" {
+		t.Fatalf("expected version 7.0 kept, got %s", got)
+	}
+}
+
+func TestResourceFeatureCompatibilityVersionImportState(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceFeatureCompatibilityVersion, client)
+
+	resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "fcv"}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.FeatureCompatibilityVersion
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Version != fake.DefaultFeatureCompatibilityVersion {
+		t.Fatalf("expected version %s in state, got %+v", fake.DefaultFeatureCompatibilityVersion, got)
+	}
+}
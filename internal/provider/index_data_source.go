@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-mongodb/internal/mongoclient/interfaces"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ datasource.DataSource              = &dataSourceIndexes{}
+	_ datasource.DataSourceWithConfigure = &dataSourceIndexes{}
+)
+
+func DataSourceIndexes() datasource.DataSource {
+	return &dataSourceIndexes{}
+}
+
+type dataSourceIndexes struct {
+	client interfaces.Client
+}
+
+func (d *dataSourceIndexes) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_indexes"
+}
+
+func (d *dataSourceIndexes) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the indexes of a collection, or of all collections of a database, with their sizes.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The database of the indexes.",
+			},
+			"collection": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The collection of the indexes. All collections of the database without the system collections if unset.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "A regular expression the index names must match, e.g. `^ttl_`. It is evaluated by MongoDB with the PCRE syntax of the `$regex` operator.",
+			},
+			"indexes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The indexes ordered by collection, in the order of listIndexes within a collection. Empty if the collection does not exist.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"collection": schema.StringAttribute{
+							Computed:    true,
+							Description: "The collection of the index.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the index.",
+						},
+						"key": schema.StringAttribute{
+							Computed:    true,
+							Description: "The key of the index as a JSON document, e.g. `{\"created_at\": -1}`.",
+						},
+						"options": schema.StringAttribute{
+							Computed:    true,
+							Description: "The options of the index as a JSON document, e.g. `unique` or `expireAfterSeconds`.",
+						},
+						"size": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The size allocated to the index in bytes.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceIndexes) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	config := types.Indexes{}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := d.client.DataSource().Index().Read(ctx, config)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read indexes", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (d *dataSourceIndexes) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(interfaces.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected interfaces.Client, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
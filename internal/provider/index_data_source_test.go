@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestDataSourceIndexesRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+	client.SetIndexes("app.orders", []types.Index{
+		{Name: "_id_", Key: `{"_id":1}`, Options: "{}", Size: 4096},
+		{Name: "ttl_created", Key: `{"created_at":1}`, Options: `{"expireAfterSeconds":3600}`, Size: 8192},
+	})
+	client.SetIndexes("app.sessions", []types.Index{
+		{Name: "ttl_seen", Key: `{"seen_at":1}`, Options: `{"expireAfterSeconds":600}`},
+	})
+
+	sessions := "sessions"
+
+	tests := []struct {
+		name       string
+		collection *string
+		want       []string
+	}{
+		{name: "database", want: []string{"orders.ttl_created", "sessions.ttl_seen"}},
+		{name: "collection", collection: &sessions, want: []string{"sessions.ttl_seen"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, state := newTestDataSource(t, DataSourceIndexes, client)
+			resp := &datasource.ReadResponse{State: state}
+
+			regex := "^ttl_"
+			config := newTestConfig(t, state, types.Indexes{Database: "app", Collection: tt.collection, NameRegex: &regex})
+
+			d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			var got types.Indexes
+			requireNoDiags(t, resp.State.Get(ctx, &got))
+
+			var names []string
+			for _, index := range got.Indexes {
+				names = append(names, index.Collection+"."+index.Name)
+			}
+
+			if len(names) != len(tt.want) || names[0] != tt.want[0] {
+				t.Fatalf("expected indexes %v, got %v", tt.want, names)
+			}
+
+			if got.Indexes[0].Collection == "orders" && got.Indexes[0].Size != 8192 {
+				t.Fatalf("expected the size of ttl_created, got %+v", got.Indexes[0])
+			}
+		})
+	}
+}
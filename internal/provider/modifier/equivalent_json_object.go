@@ -0,0 +1,53 @@
+package modifier
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+type equivalentJSONObject struct{}
+
+func (m equivalentJSONObject) Description(_ context.Context) string {
+	return "Keeps the state value when the planned JSON object has the same fields and values in another order"
+}
+
+func (m equivalentJSONObject) MarkdownDescription(_ context.Context) string {
+	return "Keeps the state value when the planned JSON object has the same fields and values in another order"
+}
+
+func (m equivalentJSONObject) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if equalJSONObjects(req.StateValue.ValueString(), req.PlanValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// EquivalentJSONObject suppresses diffs between JSON objects which differ only in field order or formatting,
+// e.g. between jsonencode output, which sorts the fields, and a value read from the server.
+func EquivalentJSONObject() planmodifier.String {
+	return equivalentJSONObject{}
+}
+
+func equalJSONObjects(a, b string) bool {
+	var objA, objB map[string]any
+
+	if decodeJSONObject(a, &objA) != nil || decodeJSONObject(b, &objB) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(objA, objB)
+}
+
+func decodeJSONObject(s string, v *map[string]any) error {
+	d := json.NewDecoder(strings.NewReader(s))
+	d.UseNumber()
+
+	return d.Decode(v)
+}
@@ -0,0 +1,62 @@
+package modifier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEquivalentJSONObject(t *testing.T) {
+	tests := []struct {
+		name  string
+		state tftypes.String
+		plan  tftypes.String
+		want  tftypes.String
+	}{
+		{
+			name:  "fields in another order",
+			state: tftypes.StringValue(`{"tenant_id":"eu","order_id":{"$minKey":1}}`),
+			plan:  tftypes.StringValue(`{"order_id": {"$minKey": 1}, "tenant_id": "eu"}`),
+			want:  tftypes.StringValue(`{"tenant_id":"eu","order_id":{"$minKey":1}}`),
+		},
+		{
+			name:  "different values",
+			state: tftypes.StringValue(`{"tenant_id":"eu"}`),
+			plan:  tftypes.StringValue(`{"tenant_id":"us"}`),
+			want:  tftypes.StringValue(`{"tenant_id":"us"}`),
+		},
+		{
+			name:  "numbers are compared exactly",
+			state: tftypes.StringValue(`{"n":1}`),
+			plan:  tftypes.StringValue(`{"n":1.0}`),
+			want:  tftypes.StringValue(`{"n":1.0}`),
+		},
+		{
+			name:  "no state",
+			state: tftypes.StringNull(),
+			plan:  tftypes.StringValue(`{"tenant_id":"eu"}`),
+			want:  tftypes.StringValue(`{"tenant_id":"eu"}`),
+		},
+		{
+			name:  "unknown plan",
+			state: tftypes.StringValue(`{"tenant_id":"eu"}`),
+			plan:  tftypes.StringUnknown(),
+			want:  tftypes.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{StateValue: tt.state, PlanValue: tt.plan}
+			resp := &planmodifier.StringResponse{PlanValue: tt.plan}
+
+			EquivalentJSONObject().PlanModifyString(context.Background(), req, resp)
+
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Fatalf("expected plan %s, got %s", tt.want, resp.PlanValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package modifier
+
+import (
+	"context"
+
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+type equivalentShardHost struct{}
+
+func (m equivalentShardHost) Description(_ context.Context) string {
+	return "Keeps the state value when the planned shard host names the same replica set"
+}
+
+func (m equivalentShardHost) MarkdownDescription(_ context.Context) string {
+	return "Keeps the state value when the planned shard host names the same replica set"
+}
+
+func (m equivalentShardHost) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if types.SameShardHost(req.StateValue.ValueString(), req.PlanValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// EquivalentShardHost suppresses diffs between shard hosts of the same replica set, e.g. between the seed
+// host passed to addShard and every member returned by listShards. It must come before RequiresReplace.
+func EquivalentShardHost() planmodifier.String {
+	return equivalentShardHost{}
+}
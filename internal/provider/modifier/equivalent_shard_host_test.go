@@ -0,0 +1,56 @@
+package modifier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEquivalentShardHost(t *testing.T) {
+	tests := []struct {
+		name  string
+		state tftypes.String
+		plan  tftypes.String
+		want  tftypes.String
+	}{
+		{
+			name:  "other members of the replica set",
+			state: tftypes.StringValue("shard01/mongo-0:27018,mongo-1:27018,mongo-2:27018"),
+			plan:  tftypes.StringValue("shard01/mongo-0:27018"),
+			want:  tftypes.StringValue("shard01/mongo-0:27018,mongo-1:27018,mongo-2:27018"),
+		},
+		{
+			name:  "other replica set",
+			state: tftypes.StringValue("shard01/mongo-0:27018"),
+			plan:  tftypes.StringValue("shard02/mongo-0:27018"),
+			want:  tftypes.StringValue("shard02/mongo-0:27018"),
+		},
+		{
+			name:  "no state",
+			state: tftypes.StringNull(),
+			plan:  tftypes.StringValue("shard01/mongo-0:27018"),
+			want:  tftypes.StringValue("shard01/mongo-0:27018"),
+		},
+		{
+			name:  "unknown plan",
+			state: tftypes.StringValue("shard01/mongo-0:27018"),
+			plan:  tftypes.StringUnknown(),
+			want:  tftypes.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{StateValue: tt.state, PlanValue: tt.plan}
+			resp := &planmodifier.StringResponse{PlanValue: tt.plan}
+
+			EquivalentShardHost().PlanModifyString(context.Background(), req, resp)
+
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Fatalf("expected plan %s, got %s", tt.want, resp.PlanValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package modifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+type immutableBool struct {
+	detail string
+}
+
+func (m immutableBool) Description(_ context.Context) string {
+	return "Prevents updates to the attribute"
+}
+
+func (m immutableBool) MarkdownDescription(_ context.Context) string {
+	return "Prevents updates to the attribute"
+}
+
+func (m immutableBool) PlanModifyBool(_ context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if req.State.Raw.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	// An unset attribute is false
+	if req.StateValue.ValueBool() != req.PlanValue.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Immutable Attribute Error",
+			fmt.Sprintf("Attribute cannot be changed. Attempted to change from '%t' to '%t'. %s", req.StateValue.ValueBool(), req.PlanValue.ValueBool(), m.detail),
+		)
+	}
+}
+
+// ImmutableBool refuses to plan a change of the attribute of an existing resource, explaining why with the detail.
+// Unset and false are the same value.
+func ImmutableBool(detail string) planmodifier.Bool {
+	return immutableBool{detail: detail}
+}
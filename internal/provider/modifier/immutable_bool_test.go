@@ -0,0 +1,45 @@
+package modifier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	tfprotoTypes "github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestImmutableBool(t *testing.T) {
+	existing := tfsdk.State{Raw: tfprotoTypes.NewValue(tfprotoTypes.Object{}, map[string]tfprotoTypes.Value{})}
+	created := tfsdk.State{Raw: tfprotoTypes.NewValue(tfprotoTypes.Object{}, nil)}
+
+	tests := []struct {
+		name    string
+		state   tfsdk.State
+		value   tftypes.Bool
+		plan    tftypes.Bool
+		wantErr bool
+	}{
+		{name: "unchanged", state: existing, value: tftypes.BoolValue(true), plan: tftypes.BoolValue(true)},
+		{name: "changed", state: existing, value: tftypes.BoolValue(true), plan: tftypes.BoolValue(false), wantErr: true},
+		{name: "set", state: existing, value: tftypes.BoolNull(), plan: tftypes.BoolValue(true), wantErr: true},
+		{name: "unset false", state: existing, value: tftypes.BoolValue(false), plan: tftypes.BoolNull()},
+		{name: "unknown plan", state: existing, value: tftypes.BoolValue(true), plan: tftypes.BoolUnknown()},
+		{name: "created", state: created, value: tftypes.BoolNull(), plan: tftypes.BoolValue(true)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.BoolRequest{Path: path.Root("unique"), State: tt.state, StateValue: tt.value, PlanValue: tt.plan}
+			resp := &planmodifier.BoolResponse{PlanValue: tt.plan}
+
+			ImmutableBool("Create a new resource instead.").PlanModifyBool(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("expected error: %t, got %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package modifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+type immutableOptionalString struct {
+	detail string
+}
+
+func (m immutableOptionalString) Description(_ context.Context) string {
+	return "Prevents updates to the attribute"
+}
+
+func (m immutableOptionalString) MarkdownDescription(_ context.Context) string {
+	return "Prevents updates to the attribute"
+}
+
+func (m immutableOptionalString) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if !req.StateValue.Equal(req.PlanValue) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Immutable Attribute Error",
+			fmt.Sprintf("Attribute cannot be changed. Attempted to change from %s to %s. %s", req.StateValue, req.PlanValue, m.detail),
+		)
+	}
+}
+
+// ImmutableOptionalString refuses to plan a change of the attribute of an existing resource, explaining why with the detail.
+// Unlike ImmutableString, setting or unsetting the attribute is a change too.
+func ImmutableOptionalString(detail string) planmodifier.String {
+	return immutableOptionalString{detail: detail}
+}
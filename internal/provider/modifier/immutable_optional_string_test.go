@@ -0,0 +1,46 @@
+package modifier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	tfprotoTypes "github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestImmutableOptionalString(t *testing.T) {
+	existing := tfsdk.State{Raw: tfprotoTypes.NewValue(tfprotoTypes.Object{}, map[string]tfprotoTypes.Value{})}
+	created := tfsdk.State{Raw: tfprotoTypes.NewValue(tfprotoTypes.Object{}, nil)}
+
+	tests := []struct {
+		name    string
+		state   tfsdk.State
+		value   tftypes.String
+		plan    tftypes.String
+		wantErr bool
+	}{
+		{name: "unchanged", state: existing, value: tftypes.StringValue("fr"), plan: tftypes.StringValue("fr")},
+		{name: "unchanged unset", state: existing, value: tftypes.StringNull(), plan: tftypes.StringNull()},
+		{name: "changed", state: existing, value: tftypes.StringValue("fr"), plan: tftypes.StringValue("simple"), wantErr: true},
+		{name: "set", state: existing, value: tftypes.StringNull(), plan: tftypes.StringValue("simple"), wantErr: true},
+		{name: "unset", state: existing, value: tftypes.StringValue("fr"), plan: tftypes.StringNull(), wantErr: true},
+		{name: "unknown plan", state: existing, value: tftypes.StringValue("fr"), plan: tftypes.StringUnknown()},
+		{name: "created", state: created, value: tftypes.StringNull(), plan: tftypes.StringValue("fr")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{Path: path.Root("collation"), State: tt.state, StateValue: tt.value, PlanValue: tt.plan}
+			resp := &planmodifier.StringResponse{PlanValue: tt.plan}
+
+			ImmutableOptionalString("Create a new resource instead.").PlanModifyString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("expected error: %t, got %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestResourceProfilerCreate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceProfiler, client)
+
+	slowMS := int64(200)
+	profiler := types.Profiler{Database: "app", Level: 1, SlowMS: &slowMS, Timeouts: testTimeouts(s, nil)}
+	resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+	r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, profiler)}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	got := client.GetProfiler("app")
+	if got.Level != 1 || got.SlowMS == nil || *got.SlowMS != 200 || got.SampleRate == nil || *got.SampleRate != types.ProfilerDefaultSampleRate {
+		t.Fatalf("expected level 1 with slowms 200 and the default sample rate, got %+v", got)
+	}
+}
+
+func TestResourceProfilerRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceProfiler, client)
+
+	slowMS := int64(300)
+	filter := `{"op":"query"}`
+	client.SetProfiler(types.Profiler{Database: "app", Level: 2, SlowMS: &slowMS, Filter: &filter})
+
+	state := newTestState(t, s, types.Profiler{Database: "app", Level: 1, Timeouts: testTimeouts(s, nil)})
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.Profiler
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Level != 2 || got.Filter == nil || *got.Filter != filter || got.SlowMS != nil {
+		t.Fatalf("expected drift of level and filter without slowms, got %+v", got)
+	}
+}
+
+func TestResourceProfilerUpdate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceProfiler, client)
+
+	filter := `{"op":"query"}`
+	client.SetProfiler(types.Profiler{Database: "app", Level: 1, Filter: &filter})
+
+	state := types.Profiler{Database: "app", Level: 1, Filter: &filter, Timeouts: testTimeouts(s, nil)}
+	plan := types.Profiler{Database: "app", Level: 2, Timeouts: testTimeouts(s, nil)}
+
+	resp := &resource.UpdateResponse{State: newTestState(t, s, state)}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: newTestPlan(t, s, plan), State: newTestState(t, s, state)}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if got := client.GetProfiler("app"); got.Level != 2 || got.Filter != nil {
+		t.Fatalf("expected level 2 without filter, got %+v", got)
+	}
+}
+
+func TestResourceProfilerDelete(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceProfiler, client)
+
+	slowMS := int64(200)
+	filter := `{"op":"query"}`
+	client.SetProfiler(types.Profiler{Database: "app", Level: 2, SlowMS: &slowMS, Filter: &filter})
+
+	state := newTestState(t, s, types.Profiler{Database: "app", Level: 2, SlowMS: &slowMS, Filter: &filter, Timeouts: testTimeouts(s, nil)})
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if got := client.GetProfiler("app"); got.Level != 0 || got.Filter != nil || got.SlowMS == nil || *got.SlowMS != 200 {
+		t.Fatalf("expected level 0 without filter keeping slowms, got %+v", got)
+	}
+}
+
+func TestResourceProfilerImportState(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceProfiler, client)
+
+	sampleRate := 0.25
+	client.SetProfiler(types.Profiler{Database: "app", Level: 1, SampleRate: &sampleRate})
+
+	resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "app"}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.Profiler
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Database != "app" || got.Level != 1 || got.SampleRate == nil || *got.SampleRate != 0.25 {
+		t.Fatalf("expected level 1 with sample rate 0.25 in state, got %+v", got)
+	}
+}
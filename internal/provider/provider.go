@@ -37,10 +37,12 @@ type mongoDBProvider struct {
 }
 
 type mongodb struct {
-	ConnectionString types.String `tfsdk:"connection_string"`
-	RetryAttempts    types.Int32  `tfsdk:"retry_attempts"`
-	RetryDelaySec    types.Int32  `tfsdk:"retry_delay_sec"`
-	DefaultTimeout   types.Int32  `tfsdk:"default_timeout"`
+	ConnectionString types.String            `tfsdk:"connection_string"`
+	RetryAttempts    types.Int32             `tfsdk:"retry_attempts"`
+	RetryDelaySec    types.Int32             `tfsdk:"retry_delay_sec"`
+	Retry            *mongoclientTypes.Retry `tfsdk:"retry"`
+	DefaultTimeout   types.Int32             `tfsdk:"default_timeout"`
+	TraceFile        types.String            `tfsdk:"trace_file"`
 }
 
 func (m *mongoDBProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -71,19 +73,22 @@ func (m *mongoDBProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				},
 			},
 			"retry_attempts": schema.Int32Attribute{
-				Optional:    true,
-				Description: "The number of retry attempts for operations that fail due to transient errors.",
+				Optional:           true,
+				Description:        "The number of retry attempts for operations that fail due to transient errors.",
+				DeprecationMessage: "Use retry.attempts instead.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
 			},
 			"retry_delay_sec": schema.Int32Attribute{
-				Optional:    true,
-				Description: "The delay in seconds between retry attempts.",
+				Optional:           true,
+				Description:        "The delay in seconds between retry attempts.",
+				DeprecationMessage: "Use retry.delay_sec instead.",
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
 			},
+			"retry": retryProviderAttribute(),
 			"default_timeout": schema.Int32Attribute{
 				Optional: true,
 				Description: "The default timeout in minutes for all resource operations (create, read, update, delete)." +
@@ -92,6 +97,11 @@ func (m *mongoDBProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 					int32validator.AtLeast(1),
 				},
 			},
+			"trace_file": schema.StringAttribute{
+				Optional: true,
+				Description: "Path to a file where a JSON trace of every operation is appended: MongoDB commands," +
+					" connection pool and topology events. Useful for diagnosing slow plans.",
+			},
 		},
 	}
 }
@@ -110,8 +120,8 @@ func (m *mongoDBProvider) Configure(ctx context.Context, req provider.ConfigureR
 
 	client := mongoclient.New(
 		config.ConnectionString.ValueString(),
-		uint(config.RetryAttempts.ValueInt32()),
-		uint(config.RetryDelaySec.ValueInt32()),
+		providerRetry(config),
+		config.TraceFile.ValueString(),
 	)
 
 	resp.DataSourceData = client
@@ -123,6 +133,9 @@ func (m *mongoDBProvider) DataSources(_ context.Context) []func() datasource.Dat
 		DataSourceDatabases,
 		DataSourceUsers,
 		DataSourceReplicaSet,
+		DataSourceSharding,
+		DataSourceCollections,
+		DataSourceIndexes,
 	}
 }
 
@@ -131,5 +144,18 @@ func (m *mongoDBProvider) Resources(_ context.Context) []func() resource.Resourc
 		ResourceDatabase,
 		ResourceUser,
 		ResourceReplicaSet,
+		ResourceShard,
+		ResourceShardedCollection,
+		ResourceShardZone,
+		ResourceZoneKeyRange,
+		ResourceBalancer,
+		ResourceDefaultRWConcern,
+		ResourceServerParameter,
+		ResourceClusterParameter,
+		ResourceFeatureCompatibilityVersion,
+		ResourceProfiler,
+		ResourceView,
+		ResourceTimeSeriesCollection,
+		ResourceClusteredCollection,
 	}
 }
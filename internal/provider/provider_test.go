@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	tfprotoTypes "github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testAccProtoV6ProviderFactories are used by the acceptance tests to run the provider in process.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"mongodb": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// newTestResource returns the resource configured with the fake client and its schema.
+func newTestResource(t *testing.T, newResource func() resource.Resource, client *fake.Client) (resource.Resource, schema.Schema) {
+	t.Helper()
+
+	ctx := context.Background()
+	r := newResource()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	requireNoDiags(t, schemaResp.Diagnostics)
+
+	configureResp := &resource.ConfigureResponse{}
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: client}, configureResp)
+	requireNoDiags(t, configureResp.Diagnostics)
+
+	return r, schemaResp.Schema
+}
+
+// newTestDataSource returns the data source configured with the fake client and a null state of its schema.
+func newTestDataSource(t *testing.T, newDataSource func() datasource.DataSource, client *fake.Client) (datasource.DataSource, tfsdk.State) {
+	t.Helper()
+
+	ctx := context.Background()
+	d := newDataSource()
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	requireNoDiags(t, schemaResp.Diagnostics)
+
+	configureResp := &datasource.ConfigureResponse{}
+	d.(datasource.DataSourceWithConfigure).Configure(ctx, datasource.ConfigureRequest{ProviderData: client}, configureResp)
+	requireNoDiags(t, configureResp.Diagnostics)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tfprotoTypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+
+	return d, state
+}
+
+// newTestPlan converts the model to a plan of the schema.
+func newTestPlan(t *testing.T, s schema.Schema, model interface{}) tfsdk.Plan {
+	t.Helper()
+
+	plan := tfsdk.Plan{Schema: s, Raw: tfprotoTypes.NewValue(s.Type().TerraformType(context.Background()), nil)}
+	requireNoDiags(t, plan.Set(context.Background(), model))
+
+	return plan
+}
+
+// newTestState converts the model to a state of the schema.
+func newTestState(t *testing.T, s schema.Schema, model interface{}) tfsdk.State {
+	t.Helper()
+
+	state := tfsdk.State{Schema: s, Raw: tfprotoTypes.NewValue(s.Type().TerraformType(context.Background()), nil)}
+	requireNoDiags(t, state.Set(context.Background(), model))
+
+	return state
+}
+
+// newTestConfig converts the model to a data source configuration of the schema of the state.
+func newTestConfig(t *testing.T, state tfsdk.State, model interface{}) tfsdk.Config {
+	t.Helper()
+
+	requireNoDiags(t, state.Set(context.Background(), model))
+
+	return tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+}
+
+// emptyTestState returns a null state of the schema, like the framework passes to Create and ImportState.
+func emptyTestState(s schema.Schema) tfsdk.State {
+	return tfsdk.State{Schema: s, Raw: tfprotoTypes.NewValue(s.Type().TerraformType(context.Background()), nil)}
+}
+
+// testTimeouts returns the timeouts attribute value of the schema with the given durations set, e.g. {"create": "1s"}.
+func testTimeouts(s schema.Schema, values map[string]string) timeouts.Value {
+	attrTypes := s.Attributes["timeouts"].GetType().(timeouts.Type).AttrTypes
+
+	if len(values) == 0 {
+		return timeouts.Value{Object: tftypes.ObjectNull(attrTypes)}
+	}
+
+	attrs := make(map[string]attr.Value, len(attrTypes))
+	for name := range attrTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = tftypes.StringValue(v)
+		} else {
+			attrs[name] = tftypes.StringNull()
+		}
+	}
+
+	return timeouts.Value{Object: tftypes.ObjectValueMust(attrTypes, attrs)}
+}
+
+func requireNoDiags(t *testing.T, diags diag.Diagnostics) {
+	t.Helper()
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func requireErrorDiag(t *testing.T, diags diag.Diagnostics, summary string) {
+	t.Helper()
+
+	for _, d := range diags.Errors() {
+		if d.Summary() == summary {
+			return
+		}
+	}
+
+	t.Fatalf("expected error diagnostic %q, got: %v", summary, diags)
+}
@@ -115,7 +115,7 @@ func (d *dataSourceReplicaSet) Read(ctx context.Context, _ datasource.ReadReques
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state.ToDataSource())...)
 }
 
 func (d *dataSourceReplicaSet) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/acctest"
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSourceReplicaSetRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	version := int64(2)
+	rs := testReplicaSet()
+	rs.Version = &version
+	client.SetReplicaSet(&rs)
+
+	d, state := newTestDataSource(t, DataSourceReplicaSet, client)
+	resp := &datasource.ReadResponse{State: state}
+
+	d.Read(ctx, datasource.ReadRequest{}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.DataSourceReplicaSet
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Name != rs.Name || len(got.Members) != len(rs.Members) {
+		t.Fatalf("expected replica set %s with %d members, got %+v", rs.Name, len(rs.Members), got)
+	}
+}
+
+func TestAccDataSourceReplicaSet(t *testing.T) {
+	acctest.PreCheck(t)
+
+	cluster := acctest.StartReplicaSet(t, true)
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: cluster.ProviderConfig() + `
+data "mongodb_replicaset" "test" {}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.mongodb_replicaset.test", "name", acctest.ReplicaSetName),
+					tfresource.TestCheckResourceAttr("data.mongodb_replicaset.test", "members.#", "3"),
+					tfresource.TestCheckResourceAttr("data.mongodb_replicaset.test", "members.0.host", cluster.Hosts()[0]),
+				),
+			},
+		},
+	})
+}
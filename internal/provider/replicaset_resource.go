@@ -151,6 +151,7 @@ func (r *resourceReplicaSet) Schema(ctx context.Context, _ resource.SchemaReques
 					},
 				},
 			},
+			"retry": retryResourceAttribute(),
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
 				Read:   true,
@@ -226,7 +227,7 @@ func (r *resourceReplicaSet) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	if r.onlyTimeoutsChanged(plan, state) {
+	if r.onlyTimeoutsOrRetryChanged(plan, state) {
 		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 		return
 	}
@@ -264,6 +265,8 @@ func (r *resourceReplicaSet) ImportState(ctx context.Context, req resource.Impor
 		return
 	}
 
+	state.Timeouts = nullTimeouts(resp.State)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -285,12 +288,15 @@ func (r *resourceReplicaSet) Configure(_ context.Context, req resource.Configure
 	r.client = client
 }
 
-func (r *resourceReplicaSet) onlyTimeoutsChanged(plan, state types.ReplicaSet) bool {
+func (r *resourceReplicaSet) onlyTimeoutsOrRetryChanged(plan, state types.ReplicaSet) bool {
 	cpPlan := plan
 	cpState := state
 
 	cpPlan.Timeouts = timeouts.Value{}
 	cpState.Timeouts = timeouts.Value{}
 
+	cpPlan.Retry = nil
+	cpState.Retry = nil
+
 	return reflect.DeepEqual(cpPlan, cpState)
 }
@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"terraform-provider-mongodb/internal/acctest"
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testReplicaSet() types.ReplicaSet {
+	return types.ReplicaSet{
+		Name: "rs0",
+		Members: []types.Member{
+			{Id: 0, Host: "mongo-0:27017"},
+			{Id: 1, Host: "mongo-1:27017"},
+			{Id: 2, Host: "mongo-2:27017"},
+		},
+	}
+}
+
+func TestResourceReplicaSetCreate(t *testing.T) {
+	tests := []struct {
+		name        string
+		initialized bool
+		inject      error
+		wantErr     bool
+	}{
+		{name: "initiates replica set"},
+		{name: "replica set already initialized", initialized: true, wantErr: true},
+		{name: "client error", inject: errors.New("connection refused"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+			client.InjectError(fake.MethodResourceReplicaSetCreate, tt.inject)
+
+			r, s := newTestResource(t, ResourceReplicaSet, client)
+
+			rs := testReplicaSet()
+			if tt.initialized {
+				client.SetReplicaSet(&rs)
+			}
+
+			rs.Timeouts = testTimeouts(s, nil)
+			resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+			r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, rs)}, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to create replica set")
+				return
+			}
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			got, ok := client.GetReplicaSet()
+			if !ok {
+				t.Fatal("replica set was not initiated")
+			}
+
+			if got.Version == nil || *got.Version != 1 {
+				t.Fatalf("expected version 1, got %v", got.Version)
+			}
+		})
+	}
+}
+
+func TestResourceReplicaSetRead(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      string
+		inject      error
+		wantRemoved bool
+		wantErr     bool
+	}{
+		{name: "replica set exists", config: "rs0"},
+		{name: "replica set renamed outside of terraform", config: "rs1", wantRemoved: true},
+		{name: "replica set not initialized", wantErr: true},
+		{name: "client error", config: "rs0", inject: errors.New("connection refused"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+			client.InjectError(fake.MethodResourceReplicaSetExists, tt.inject)
+
+			r, s := newTestResource(t, ResourceReplicaSet, client)
+
+			rs := testReplicaSet()
+			if tt.config != "" {
+				config := testReplicaSet()
+				config.Name = tt.config
+				client.SetReplicaSet(&config)
+			}
+
+			rs.Timeouts = testTimeouts(s, nil)
+			state := newTestState(t, s, rs)
+			resp := &resource.ReadResponse{State: state}
+
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to check replica set existence")
+				return
+			}
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			if resp.State.Raw.IsNull() != tt.wantRemoved {
+				t.Fatalf("expected resource removed from state: %t, got: %t", tt.wantRemoved, resp.State.Raw.IsNull())
+			}
+		})
+	}
+}
+
+func TestResourceReplicaSetUpdate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceReplicaSet, client)
+
+	version := int64(3)
+	config := testReplicaSet()
+	config.Version = &version
+	client.SetReplicaSet(&config)
+
+	rs := testReplicaSet()
+	rs.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, rs)
+
+	hidden := true
+	priority := float64(0)
+	rs.Members[2].Hidden = &hidden
+	rs.Members[2].Priority = &priority
+	plan := newTestPlan(t, s, rs)
+
+	resp := &resource.UpdateResponse{State: state}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	got, _ := client.GetReplicaSet()
+	if got.Version == nil || *got.Version != version+1 {
+		t.Fatalf("expected version %d, got %v", version+1, got.Version)
+	}
+
+	if got.Members[2].Hidden == nil || !*got.Members[2].Hidden {
+		t.Fatal("expected member 2 to be hidden")
+	}
+}
+
+func TestResourceReplicaSetUpdateOnlyTimeouts(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceReplicaSet, client)
+
+	rs := testReplicaSet()
+	client.SetReplicaSet(&rs)
+
+	rs.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, rs)
+
+	rs.Timeouts = testTimeouts(s, map[string]string{"update": "10m"})
+	plan := newTestPlan(t, s, rs)
+
+	resp := &resource.UpdateResponse{State: state}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if calls := client.Calls(fake.MethodResourceReplicaSetUpdate); calls != 0 {
+		t.Fatalf("expected no update calls when only timeouts changed, got %d", calls)
+	}
+}
+
+func TestResourceReplicaSetImportState(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceReplicaSet, client)
+
+	version := int64(7)
+	votes := int64(1)
+	config := testReplicaSet()
+	config.Version = &version
+	config.Members[0].Votes = &votes
+	client.SetReplicaSet(&config)
+
+	resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: config.Name}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.ReplicaSet
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Version != nil {
+		t.Fatalf("expected no version in imported state, got %d", *got.Version)
+	}
+
+	if got.Members[0].Votes != nil {
+		t.Fatal("expected default votes to be removed from imported state")
+	}
+}
+
+func TestAccResourceReplicaSet(t *testing.T) {
+	acctest.PreCheck(t)
+
+	cluster := acctest.StartReplicaSet(t, false)
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: cluster.ProviderConfig() + testAccResourceReplicaSetConfig(cluster, false),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("mongodb_replicaset.test", "name", acctest.ReplicaSetName),
+					tfresource.TestCheckResourceAttr("mongodb_replicaset.test", "members.#", "3"),
+				),
+			},
+			{
+				ResourceName:                         "mongodb_replicaset.test",
+				ImportState:                          true,
+				ImportStateId:                        acctest.ReplicaSetName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			{
+				Config: cluster.ProviderConfig() + testAccResourceReplicaSetConfig(cluster, true),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("mongodb_replicaset.test", "members.2.hidden", "true"),
+					tfresource.TestCheckResourceAttr("mongodb_replicaset.test", "members.2.priority", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAccResourceReplicaSetConfig returns the replica set of all cluster nodes. When hidden is true
+// the last member is hidden, which is the typical change made to a running replica set.
+func testAccResourceReplicaSetConfig(cluster *acctest.Cluster, hidden bool) string {
+	members := make([]string, 0, len(cluster.Nodes))
+	for i, host := range cluster.Hosts() {
+		member := fmt.Sprintf("    {\n      id   = %d\n      host = %q\n", i, host)
+		if hidden && i == len(cluster.Nodes)-1 {
+			member += "      hidden   = true\n      priority = 0\n"
+		}
+
+		members = append(members, member+"    }")
+	}
+
+	return fmt.Sprintf(`
+resource "mongodb_replicaset" "test" {
+  name = %q
+
+  members = [
+%s
+  ]
+}
+`, acctest.ReplicaSetName, strings.Join(members, ",\n"))
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	mongoclientTypes "terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	providerSchema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	retryAttemptsValidators = []validator.Int64{int64validator.AtLeast(1)}
+	retryDelayValidators    = []validator.Int64{int64validator.AtLeast(1)}
+	retryJitterValidators   = []validator.Int64{int64validator.AtLeast(0)}
+	retryBackoffValidators  = []validator.String{
+		stringvalidator.OneOf(mongoclientTypes.RetryBackoffFixed, mongoclientTypes.RetryBackoffExponential),
+	}
+)
+
+const (
+	retryAttemptsDescription    = "The number of attempts for operations that fail due to transient errors. Default is 5."
+	retryDelaySecDescription    = "The delay in seconds before the first retry. Default is 3."
+	retryMaxDelaySecDescription = "The maximum delay in seconds between attempts. By default the delay is not capped."
+	retryJitterSecDescription   = "The maximum random jitter in seconds added to each delay. By default there is no jitter."
+	retryBackoffDescription     = "The backoff type: `fixed` keeps the delay constant, `exponential` doubles it after each attempt. Default is `exponential`."
+)
+
+// retryProviderAttribute returns the retry block of the provider schema.
+func retryProviderAttribute() providerSchema.SingleNestedAttribute {
+	return providerSchema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "The retry policy for all operations. Can be overridden in each resource.",
+		Attributes: map[string]providerSchema.Attribute{
+			"attempts": providerSchema.Int64Attribute{
+				Optional:    true,
+				Description: retryAttemptsDescription,
+				Validators:  retryAttemptsValidators,
+			},
+			"delay_sec": providerSchema.Int64Attribute{
+				Optional:    true,
+				Description: retryDelaySecDescription,
+				Validators:  retryDelayValidators,
+			},
+			"max_delay_sec": providerSchema.Int64Attribute{
+				Optional:    true,
+				Description: retryMaxDelaySecDescription,
+				Validators:  retryDelayValidators,
+			},
+			"jitter_sec": providerSchema.Int64Attribute{
+				Optional:    true,
+				Description: retryJitterSecDescription,
+				Validators:  retryJitterValidators,
+			},
+			"backoff": providerSchema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: retryBackoffDescription,
+				Validators:          retryBackoffValidators,
+			},
+		},
+	}
+}
+
+// retryResourceAttribute returns the retry block of the resource schema, which overrides the provider retry policy.
+func retryResourceAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Overrides the provider retry policy for this resource. Unset fields are taken from the provider.",
+		Attributes: map[string]schema.Attribute{
+			"attempts": schema.Int64Attribute{
+				Optional:    true,
+				Description: retryAttemptsDescription,
+				Validators:  retryAttemptsValidators,
+			},
+			"delay_sec": schema.Int64Attribute{
+				Optional:    true,
+				Description: retryDelaySecDescription,
+				Validators:  retryDelayValidators,
+			},
+			"max_delay_sec": schema.Int64Attribute{
+				Optional:    true,
+				Description: retryMaxDelaySecDescription,
+				Validators:  retryDelayValidators,
+			},
+			"jitter_sec": schema.Int64Attribute{
+				Optional:    true,
+				Description: retryJitterSecDescription,
+				Validators:  retryJitterValidators,
+			},
+			"backoff": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: retryBackoffDescription,
+				Validators:          retryBackoffValidators,
+			},
+		},
+	}
+}
+
+// providerRetry returns the retry policy of the provider configuration. The deprecated retry_attempts and
+// retry_delay_sec attributes are used only when the retry block does not set them.
+func providerRetry(config mongodb) *mongoclientTypes.Retry {
+	retry := &mongoclientTypes.Retry{}
+	if config.Retry != nil {
+		*retry = *config.Retry
+	}
+
+	if retry.Attempts == nil && config.RetryAttempts.ValueInt32() > 0 {
+		attempts := int64(config.RetryAttempts.ValueInt32())
+		retry.Attempts = &attempts
+	}
+
+	if retry.DelaySec == nil && config.RetryDelaySec.ValueInt32() > 0 {
+		delay := int64(config.RetryDelaySec.ValueInt32())
+		retry.DelaySec = &delay
+	}
+
+	return retry
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/executor"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProviderRetry(t *testing.T) {
+	int64Ptr := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name         string
+		config       mongodb
+		wantAttempts *int64
+		wantDelaySec *int64
+	}{
+		{
+			name:   "nothing set",
+			config: mongodb{RetryAttempts: tftypes.Int32Null(), RetryDelaySec: tftypes.Int32Null()},
+		},
+		{
+			name:         "deprecated attributes",
+			config:       mongodb{RetryAttempts: tftypes.Int32Value(7), RetryDelaySec: tftypes.Int32Value(2)},
+			wantAttempts: int64Ptr(7),
+			wantDelaySec: int64Ptr(2),
+		},
+		{
+			name: "retry block wins over deprecated attributes",
+			config: mongodb{
+				RetryAttempts: tftypes.Int32Value(7),
+				RetryDelaySec: tftypes.Int32Value(2),
+				Retry:         &types.Retry{Attempts: int64Ptr(3)},
+			},
+			wantAttempts: int64Ptr(3),
+			wantDelaySec: int64Ptr(2),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := providerRetry(tt.config)
+
+			if !equalInt64Ptr(got.Attempts, tt.wantAttempts) || !equalInt64Ptr(got.DelaySec, tt.wantDelaySec) {
+				t.Fatalf("expected attempts %v and delay %v, got %v and %v", tt.wantAttempts, tt.wantDelaySec, got.Attempts, got.DelaySec)
+			}
+		})
+	}
+}
+
+func TestProviderRetryKeepsRetryBlock(t *testing.T) {
+	attempts := int64(3)
+	block := &types.Retry{Attempts: &attempts}
+
+	providerRetry(mongodb{RetryDelaySec: tftypes.Int32Value(2), Retry: block})
+
+	if block.DelaySec != nil {
+		t.Fatalf("expected the configured retry block unchanged, got delay %d", *block.DelaySec)
+	}
+}
+
+// TestProviderRetryResourceOverride merges the provider policy from deprecated attributes with a resource override,
+// as the executor does for each operation.
+func TestProviderRetryResourceOverride(t *testing.T) {
+	delay := int64(10)
+
+	policy := executor.DefaultPolicy().
+		Merge(providerRetry(mongodb{RetryAttempts: tftypes.Int32Value(7), RetryDelaySec: tftypes.Int32Value(2)})).
+		Merge(&types.Retry{DelaySec: &delay})
+
+	if policy.Attempts != 7 || policy.Delay.Seconds() != 10 {
+		t.Fatalf("expected 7 attempts from the provider and a 10s delay from the resource, got %+v", policy)
+	}
+}
+
+func equalInt64Ptr(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-mongodb/internal/mongoclient/interfaces"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &resourceServerParameter{}
+	_ resource.ResourceWithConfigure   = &resourceServerParameter{}
+	_ resource.ResourceWithImportState = &resourceServerParameter{}
+)
+
+func ResourceServerParameter() resource.Resource {
+	return &resourceServerParameter{}
+}
+
+type resourceServerParameter struct {
+	client interfaces.Client
+}
+
+func (r *resourceServerParameter) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_parameter"
+}
+
+func (r *resourceServerParameter) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sets a runtime parameter with setParameter on one node or on every replica set member, connecting to each host directly. " +
+			"The value of each host before the parameter was set is kept in the state and restored when the resource is deleted.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The name of the parameter, e.g. logLevel or transactionLifetimeLimitSeconds.",
+			},
+			"value": schema.StringAttribute{
+				Required: true,
+				Description: "The value in MongoDB Extended JSON, which sets its type: `jsonencode(2)` is an integer, `jsonencode(\"debug\")` a string, " +
+					"`jsonencode(true)` a boolean and `jsonencode({ \"$numberLong\" = \"2\" })` a 64-bit integer. Numbers are compared by value, so 1 and 1.0 are equal.",
+			},
+			"host": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("all_members")),
+				},
+				Description: "The host:port of the node the parameter is set on. By default it is the first host of the connection string.",
+			},
+			"all_members": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Description: "Whether the parameter is set on every member of the replica set configuration. Members added later are not updated.",
+			},
+			"previous_values": schema.MapAttribute{
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The values of the parameter before it was set by host, restored when the resource is deleted.",
+			},
+			"retry": retryResourceAttribute(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceServerParameter) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := types.ServerParameter{}
+
+	// previous_values is unknown until the parameter is set
+	planned, err := nullUnknown(req.Plan, "previous_values")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read plan", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(planned.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	plan, err = r.client.Resource().ServerParameter().Create(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create server parameter", err.Error())
+
+		// Keep the previous values of the hosts already set, the tainted resource restores them when it is replaced
+		if len(plan.PreviousValues) > 0 {
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceServerParameter) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := types.ServerParameter{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	state, err := r.client.Resource().ServerParameter().Read(apiCtx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read server parameter", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceServerParameter) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := types.ServerParameter{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	err := r.client.Resource().ServerParameter().Update(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update server parameter", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceServerParameter) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := types.ServerParameter{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.Resource().ServerParameter().Delete(apiCtx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete server parameter", err.Error())
+		return
+	}
+}
+
+// ImportState imports the parameter by the <name>, <host>/<name> or */<name> ID.
+func (r *resourceServerParameter) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	state, err := r.client.Resource().ServerParameter().ImportState(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import server parameter", err.Error())
+		return
+	}
+
+	state.Timeouts = nullTimeouts(resp.State)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceServerParameter) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(interfaces.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected interfaces.Client, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResourceServerParameterCreate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceServerParameter, client)
+
+	client.SetServerParameter(fake.DefaultHost, "logLevel", "0")
+
+	parameter := types.ServerParameter{Name: "logLevel", Value: "2", Timeouts: testTimeouts(s, nil)}
+	resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+	plan := newTestPlan(t, s, parameter)
+	requireNoDiags(t, plan.SetAttribute(ctx, path.Root("previous_values"), tftypes.MapUnknown(tftypes.StringType)))
+
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if got, _ := client.GetServerParameter(fake.DefaultHost, "logLevel"); got != "2" {
+		t.Fatalf("expected logLevel 2, got %s", got)
+	}
+
+	var got types.ServerParameter
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.PreviousValues[fake.DefaultHost] != "0" {
+		t.Fatalf("expected previous value 0 in state, got %v", got.PreviousValues)
+	}
+}
+
+func TestResourceServerParameterCreatePartial(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceServerParameter, client)
+
+	rs := testReplicaSet()
+	client.SetReplicaSet(&rs)
+	client.SetServerParameter("mongo-0:27017", "logLevel", "0")
+	client.SetServerParameter("mongo-1:27017", "logLevel", "1")
+
+	allMembers := true
+	parameter := types.ServerParameter{Name: "logLevel", Value: "2", AllMembers: &allMembers, Timeouts: testTimeouts(s, nil)}
+	resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+	plan := newTestPlan(t, s, parameter)
+	requireNoDiags(t, plan.SetAttribute(ctx, path.Root("previous_values"), tftypes.MapUnknown(tftypes.StringType)))
+
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+	requireErrorDiag(t, resp.Diagnostics, "Failed to create server parameter")
+
+	var got types.ServerParameter
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	want := map[string]string{"mongo-0:27017": "0", "mongo-1:27017": "1"}
+	if !maps.Equal(got.PreviousValues, want) {
+		t.Fatalf("expected the previous values %v of the hosts already set in state, got %v", want, got.PreviousValues)
+	}
+}
+
+func TestResourceServerParameterCreateUnknown(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceServerParameter, client)
+
+	parameter := types.ServerParameter{Name: "noSuchParameter", Value: "1", Timeouts: testTimeouts(s, nil)}
+	resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+	r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, parameter)}, resp)
+
+	requireErrorDiag(t, resp.Diagnostics, "Failed to create server parameter")
+}
+
+func TestResourceServerParameterRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceServerParameter, client)
+
+	client.SetServerParameter(fake.DefaultHost, "logLevel", "5")
+
+	parameter := types.ServerParameter{
+		Name:           "logLevel",
+		Value:          "2",
+		PreviousValues: map[string]string{fake.DefaultHost: "0"},
+		Timeouts:       testTimeouts(s, nil),
+	}
+	state := newTestState(t, s, parameter)
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.ServerParameter
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Value != "5" || got.PreviousValues[fake.DefaultHost] != "0" {
+		t.Fatalf("expected drift to 5 keeping the previous value, got %+v", got)
+	}
+}
+
+func TestResourceServerParameterUpdate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceServerParameter, client)
+
+	client.SetServerParameter(fake.DefaultHost, "logLevel", "2")
+
+	state := types.ServerParameter{
+		Name:           "logLevel",
+		Value:          "2",
+		PreviousValues: map[string]string{fake.DefaultHost: "0"},
+		Timeouts:       testTimeouts(s, nil),
+	}
+	plan := state
+	plan.Value = "3"
+
+	resp := &resource.UpdateResponse{State: newTestState(t, s, state)}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: newTestPlan(t, s, plan), State: newTestState(t, s, state)}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if got, _ := client.GetServerParameter(fake.DefaultHost, "logLevel"); got != "3" {
+		t.Fatalf("expected logLevel 3, got %s", got)
+	}
+}
+
+func TestResourceServerParameterDelete(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceServerParameter, client)
+
+	client.SetServerParameter(fake.DefaultHost, "logLevel", "2")
+
+	parameter := types.ServerParameter{
+		Name:           "logLevel",
+		Value:          "2",
+		PreviousValues: map[string]string{fake.DefaultHost: "0"},
+		Timeouts:       testTimeouts(s, nil),
+	}
+	state := newTestState(t, s, parameter)
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if got, _ := client.GetServerParameter(fake.DefaultHost, "logLevel"); got != "0" {
+		t.Fatalf("expected logLevel restored to 0, got %s", got)
+	}
+}
+
+func TestResourceServerParameterImportState(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceServerParameter, client)
+
+	client.SetServerParameter("mongo-1:27017", "logLevel", "1")
+
+	resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "mongo-1:27017/logLevel"}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.ServerParameter
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Name != "logLevel" || got.Host == nil || *got.Host != "mongo-1:27017" || got.Value != "1" {
+		t.Fatalf("expected logLevel 1 of mongo-1:27017 in state, got %+v", got)
+	}
+}
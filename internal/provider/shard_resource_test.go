@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testShard() types.Shard {
+	return types.Shard{Name: "shard01", Host: "shard01/mongo-0:27018,mongo-1:27018"}
+}
+
+func TestResourceShardCreate(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing bool
+		inject   error
+		wantErr  bool
+	}{
+		{name: "adds shard"},
+		{name: "shard already exists", existing: true, wantErr: true},
+		{name: "client error", inject: errors.New("not mongos"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+			client.InjectError(fake.MethodResourceShardCreate, tt.inject)
+
+			r, s := newTestResource(t, ResourceShard, client)
+
+			shard := testShard()
+			if tt.existing {
+				client.AddShard(shard.Name, shard.Host)
+			}
+
+			shard.Timeouts = testTimeouts(s, nil)
+			resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+			r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, shard)}, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to create shard")
+				return
+			}
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			if host, _ := client.GetShard(shard.Name); host != shard.Host {
+				t.Fatalf("expected shard host %q, got %q", shard.Host, host)
+			}
+		})
+	}
+}
+
+func TestResourceShardRead(t *testing.T) {
+	tests := []struct {
+		name        string
+		existing    bool
+		serverHost  string
+		wantHost    string
+		wantRemoved bool
+	}{
+		{name: "shard exists", existing: true, serverHost: testShard().Host, wantHost: testShard().Host},
+		{name: "more members listed", existing: true, serverHost: "shard01/mongo-0:27018,mongo-1:27018,mongo-2:27018", wantHost: testShard().Host},
+		{name: "other replica set", existing: true, serverHost: "shard02/mongo-5:27018", wantHost: "shard02/mongo-5:27018"},
+		{name: "shard removed outside of terraform", wantRemoved: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+
+			r, s := newTestResource(t, ResourceShard, client)
+
+			shard := testShard()
+			if tt.existing {
+				client.AddShard(shard.Name, tt.serverHost)
+			}
+
+			shard.Timeouts = testTimeouts(s, nil)
+			state := newTestState(t, s, shard)
+			resp := &resource.ReadResponse{State: state}
+
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			if resp.State.Raw.IsNull() != tt.wantRemoved {
+				t.Fatalf("expected resource removed from state: %t, got: %t", tt.wantRemoved, resp.State.Raw.IsNull())
+			}
+
+			if tt.wantRemoved {
+				return
+			}
+
+			var got types.Shard
+			requireNoDiags(t, resp.State.Get(ctx, &got))
+
+			if got.Host != tt.wantHost {
+				t.Fatalf("expected host %s in state, got %s", tt.wantHost, got.Host)
+			}
+		})
+	}
+}
+
+func TestResourceShardDelete(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceShard, client)
+
+	shard := testShard()
+	client.AddShard(shard.Name, shard.Host)
+
+	shard.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, shard)
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if _, ok := client.GetShard(shard.Name); ok {
+		t.Fatal("shard was not removed")
+	}
+}
+
+func TestResourceShardDeleteMissing(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceShard, client)
+
+	shard := testShard()
+	shard.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, shard)
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+}
+
+func TestResourceShardImportState(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceShard, client)
+
+	shard := testShard()
+	client.AddShard(shard.Name, shard.Host)
+
+	resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: shard.Name}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.Shard
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Name != shard.Name || got.Host != shard.Host {
+		t.Fatalf("expected shard %+v in state, got %+v", shard, got)
+	}
+}
+
+// TestResourceShardImportStatePlan plans the configured seed host of an imported shard, which listShards
+// reports with every member, and expects no replacement.
+func TestResourceShardImportStatePlan(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceShard, client)
+
+	client.AddShard("shard01", "shard01/mongo-0:27018,mongo-1:27018,mongo-2:27018")
+
+	resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "shard01"}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	readResp := &resource.ReadResponse{State: resp.State}
+
+	r.Read(ctx, resource.ReadRequest{State: resp.State}, readResp)
+
+	requireNoDiags(t, readResp.Diagnostics)
+
+	var state types.Shard
+	requireNoDiags(t, readResp.State.Get(ctx, &state))
+
+	config := tftypes.StringValue("shard01/mongo-0:27018")
+	req := planmodifier.StringRequest{
+		Path:        path.Root("host"),
+		ConfigValue: config,
+		PlanValue:   config,
+		StateValue:  tftypes.StringValue(state.Host),
+		Plan:        newTestPlan(t, s, types.Shard{Name: "shard01", Host: config.ValueString(), Timeouts: testTimeouts(s, nil)}),
+		State:       readResp.State,
+	}
+	planResp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+
+	for _, m := range s.Attributes["host"].(schema.StringAttribute).PlanModifiers {
+		m.PlanModifyString(ctx, req, planResp)
+		req.PlanValue = planResp.PlanValue
+	}
+
+	requireNoDiags(t, planResp.Diagnostics)
+
+	if planResp.RequiresReplace {
+		t.Fatalf("expected no replacement of the imported shard, planned host %s against %s", planResp.PlanValue, state.Host)
+	}
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestResourceShardZoneCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		shard   bool
+		zoned   bool
+		wantErr bool
+	}{
+		{name: "adds shard to zone", shard: true},
+		{name: "shard already in zone", shard: true, zoned: true, wantErr: true},
+		{name: "shard does not exist", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+
+			r, s := newTestResource(t, ResourceShardZone, client)
+
+			if tt.shard {
+				client.AddShard("shard01", testShard().Host)
+			}
+
+			if tt.zoned {
+				client.AddShardZone("shard01", "EU")
+			}
+
+			zone := types.ShardZone{Shard: "shard01", Zone: "EU", Timeouts: testTimeouts(s, nil)}
+			resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+			r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, zone)}, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to create shard zone")
+				return
+			}
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			if !slices.Contains(client.ShardZones("shard01"), "EU") {
+				t.Fatal("expected shard01 in zone EU")
+			}
+		})
+	}
+}
+
+func TestResourceShardZoneRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceShardZone, client)
+
+	client.AddShard("shard01", testShard().Host)
+	client.AddShardZone("shard01", "EU")
+
+	zone := types.ShardZone{Shard: "shard01", Zone: "EU", Timeouts: testTimeouts(s, nil)}
+	state := newTestState(t, s, zone)
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if resp.State.Raw.IsNull() {
+		t.Fatal("expected shard zone to stay in state")
+	}
+
+	client.RemoveShardZone("shard01", "EU")
+
+	resp = &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if !resp.State.Raw.IsNull() {
+		t.Fatal("expected shard zone removed outside of terraform to be removed from state")
+	}
+}
+
+func TestResourceShardZoneDelete(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceShardZone, client)
+
+	client.AddShard("shard01", testShard().Host)
+	client.AddShardZone("shard01", "EU")
+
+	zone := types.ShardZone{Shard: "shard01", Zone: "EU", Timeouts: testTimeouts(s, nil)}
+	state := newTestState(t, s, zone)
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if len(client.ShardZones("shard01")) != 0 {
+		t.Fatal("shard was not removed from zone")
+	}
+}
+
+func TestResourceShardZoneImportState(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceShardZone, client)
+
+	client.AddShard("shard01", testShard().Host)
+	client.AddShardZone("shard01", "EU")
+
+	resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "shard01/EU"}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.ShardZone
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Shard != "shard01" || got.Zone != "EU" {
+		t.Fatalf("expected shard01 in zone EU in state, got %+v", got)
+	}
+}
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-mongodb/internal/mongoclient/interfaces"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+	"terraform-provider-mongodb/internal/provider/modifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ resource.Resource                = &resourceShardedCollection{}
+	_ resource.ResourceWithConfigure   = &resourceShardedCollection{}
+	_ resource.ResourceWithImportState = &resourceShardedCollection{}
+)
+
+func ResourceShardedCollection() resource.Resource {
+	return &resourceShardedCollection{}
+}
+
+type resourceShardedCollection struct {
+	client interfaces.Client
+}
+
+func (r *resourceShardedCollection) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sharded_collection"
+}
+
+func (r *resourceShardedCollection) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Shards a collection, enabling sharding on its database if needed. The provider must be connected to a mongos. " +
+			"A shard key extended with new fields is refined in place, any other key change reshards the collection. " +
+			"Collections can't be unsharded, so deleting the resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The database of the collection.",
+			},
+			"collection": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The name of the collection.",
+			},
+			"key": schema.ListNestedAttribute{
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+							Description: "The name of the field.",
+						},
+						"type": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(types.ShardKeyAscending, types.ShardKeyHashed),
+							},
+							Description: "The type of the field: `ascending` or `hashed`.",
+						},
+					},
+				},
+				Description: "The fields of the shard key in order. Appending fields refines the key with refineCollectionShardKey, " +
+					"which requires an index supporting the new key. Any other change reshards the collection with reshardCollection.",
+			},
+			"unique": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					modifier.ImmutableBool("MongoDB can't change the uniqueness of a shard key, and as collections can't be unsharded " +
+						"the collection can't be recreated either. Shard a new collection and copy the data into it."),
+				},
+				Description: "Whether the shard key index enforces uniqueness. A unique key can be refined, but not resharded. " +
+					"It can't be changed once the collection is sharded.",
+			},
+			"num_initial_chunks": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "The number of chunks to create initially when sharding an empty collection with a hashed key, or when resharding.",
+			},
+			"presplit_hashed_zones": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to create the initial chunks of a compound hashed key according to the zones of the cluster. Only used when the collection is sharded.",
+			},
+			"collation": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					modifier.ImmutableOptionalString("The collation of the shard key index is set when the collection is sharded, " +
+						"and as collections can't be unsharded the collection can't be recreated either."),
+				},
+				Description: "The collation locale of the shard key index. Must be `simple` when the collection has a default collation. " +
+					"It can't be changed once the collection is sharded.",
+			},
+			"retry": retryResourceAttribute(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceShardedCollection) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := types.ShardedCollection{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	err := r.client.Resource().ShardedCollection().Create(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create sharded collection", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceShardedCollection) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := types.ShardedCollection{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	state, exist, err := r.client.Resource().ShardedCollection().Read(apiCtx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read sharded collection", err.Error())
+		return
+	}
+
+	if !exist {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update changes the shard key. Changes of the other options are only stored, because they are used
+// when the collection is sharded or resharded.
+func (r *resourceShardedCollection) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := types.ShardedCollection{}
+	state := types.ShardedCollection{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Key.Equal(state.Key) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	err := r.client.Resource().ShardedCollection().Update(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update sharded collection", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceShardedCollection) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning(
+		"Delete Not Supported",
+		"Collections can't be unsharded. The collection stays sharded and is only removed from the Terraform state.",
+	)
+}
+
+func (r *resourceShardedCollection) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	namespace := req.ID
+
+	state, err := r.client.Resource().ShardedCollection().ImportState(ctx, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import sharded collection", err.Error())
+		return
+	}
+
+	state.Timeouts = nullTimeouts(resp.State)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceShardedCollection) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(interfaces.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected interfaces.Client, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testShardedCollection() types.ShardedCollection {
+	return types.ShardedCollection{
+		Database:   "app",
+		Collection: "orders",
+		Key: types.ShardKey{
+			{Field: "tenant_id", Type: types.ShardKeyAscending},
+		},
+	}
+}
+
+func TestResourceShardedCollectionCreate(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing types.ShardKey
+		inject   error
+		wantErr  bool
+	}{
+		{name: "shards collection"},
+		{name: "collection sharded by a lost reply", existing: testShardedCollection().Key},
+		{name: "collection sharded with another key", existing: types.ShardKey{{Field: "tenant_id", Type: types.ShardKeyHashed}}, wantErr: true},
+		{name: "client error", inject: errors.New("not mongos"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+			client.InjectError(fake.MethodResourceShardedCollectionCreate, tt.inject)
+
+			r, s := newTestResource(t, ResourceShardedCollection, client)
+
+			collection := testShardedCollection()
+			if tt.existing != nil {
+				existing := testShardedCollection()
+				existing.Key = tt.existing
+				client.ShardCollection(existing)
+			}
+
+			collection.Timeouts = testTimeouts(s, nil)
+			resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+			r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, collection)}, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to create sharded collection")
+				return
+			}
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			got, ok := client.GetShardedCollection("app.orders")
+			if !ok || !got.Key.Equal(collection.Key) {
+				t.Fatalf("expected collection sharded by %v, got %v", collection.Key, got.Key)
+			}
+		})
+	}
+}
+
+func TestResourceShardedCollectionRead(t *testing.T) {
+	tests := []struct {
+		name        string
+		sharded     *types.ShardedCollection
+		wantKey     types.ShardKey
+		wantRemoved bool
+	}{
+		{
+			name:    "collection sharded",
+			sharded: &types.ShardedCollection{Database: "app", Collection: "orders", Key: testShardedCollection().Key},
+			wantKey: testShardedCollection().Key,
+		},
+		{
+			name: "key changed outside of terraform",
+			sharded: &types.ShardedCollection{Database: "app", Collection: "orders", Key: types.ShardKey{
+				{Field: "region", Type: types.ShardKeyHashed},
+			}},
+			wantKey: types.ShardKey{{Field: "region", Type: types.ShardKeyHashed}},
+		},
+		{name: "collection dropped outside of terraform", wantRemoved: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+
+			r, s := newTestResource(t, ResourceShardedCollection, client)
+
+			if tt.sharded != nil {
+				client.ShardCollection(*tt.sharded)
+			}
+
+			collection := testShardedCollection()
+			collection.Timeouts = testTimeouts(s, nil)
+			state := newTestState(t, s, collection)
+			resp := &resource.ReadResponse{State: state}
+
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			if resp.State.Raw.IsNull() != tt.wantRemoved {
+				t.Fatalf("expected resource removed from state: %t, got: %t", tt.wantRemoved, resp.State.Raw.IsNull())
+			}
+
+			if tt.wantRemoved {
+				return
+			}
+
+			var got types.ShardedCollection
+			requireNoDiags(t, resp.State.Get(ctx, &got))
+
+			if !got.Key.Equal(tt.wantKey) {
+				t.Fatalf("expected key %v in state, got %v", tt.wantKey, got.Key)
+			}
+
+			if got.Unique != nil {
+				t.Fatalf("expected unset unique flag, got %t", *got.Unique)
+			}
+		})
+	}
+}
+
+func TestResourceShardedCollectionUpdate(t *testing.T) {
+	unique := true
+
+	tests := []struct {
+		name        string
+		unique      *bool
+		key         types.ShardKey
+		wantErr     bool
+		wantUpdates int
+	}{
+		{
+			name: "refines key",
+			key: types.ShardKey{
+				{Field: "tenant_id", Type: types.ShardKeyAscending},
+				{Field: "order_id", Type: types.ShardKeyAscending},
+			},
+			wantUpdates: 1,
+		},
+		{
+			name:        "reshards collection",
+			key:         types.ShardKey{{Field: "order_id", Type: types.ShardKeyHashed}},
+			wantUpdates: 1,
+		},
+		{
+			name:        "unique key can't be resharded",
+			unique:      &unique,
+			key:         types.ShardKey{{Field: "order_id", Type: types.ShardKeyHashed}},
+			wantErr:     true,
+			wantUpdates: 1,
+		},
+		{
+			name: "same key",
+			key:  testShardedCollection().Key,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+
+			r, s := newTestResource(t, ResourceShardedCollection, client)
+
+			collection := testShardedCollection()
+			collection.Unique = tt.unique
+			client.ShardCollection(collection)
+
+			collection.Timeouts = testTimeouts(s, nil)
+			state := newTestState(t, s, collection)
+
+			chunks := int64(8)
+			collection.Key = tt.key
+			collection.NumInitialChunks = &chunks
+			plan := newTestPlan(t, s, collection)
+
+			resp := &resource.UpdateResponse{State: state}
+
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+			if calls := client.Calls(fake.MethodResourceShardedCollectionUpdate); calls != tt.wantUpdates {
+				t.Fatalf("expected %d update calls, got %d", tt.wantUpdates, calls)
+			}
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to update sharded collection")
+				return
+			}
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			got, _ := client.GetShardedCollection("app.orders")
+			if !got.Key.Equal(tt.key) {
+				t.Fatalf("expected key %v, got %v", tt.key, got.Key)
+			}
+		})
+	}
+}
+
+// TestResourceShardedCollectionPlanUnique plans a change of unique, which the collection can't be recreated for.
+func TestResourceShardedCollectionPlanUnique(t *testing.T) {
+	ctx := context.Background()
+
+	_, s := newTestResource(t, ResourceShardedCollection, fake.New())
+
+	unique := true
+	collection := testShardedCollection()
+	collection.Unique = &unique
+	collection.Timeouts = testTimeouts(s, nil)
+
+	req := planmodifier.BoolRequest{
+		Path:        path.Root("unique"),
+		ConfigValue: tftypes.BoolValue(false),
+		PlanValue:   tftypes.BoolValue(false),
+		StateValue:  tftypes.BoolValue(true),
+		State:       newTestState(t, s, collection),
+	}
+	resp := &planmodifier.BoolResponse{PlanValue: req.PlanValue}
+
+	for _, m := range s.Attributes["unique"].(schema.BoolAttribute).PlanModifiers {
+		m.PlanModifyBool(ctx, req, resp)
+	}
+
+	if resp.RequiresReplace {
+		t.Fatal("expected no replacement, the replacement can't shard the collection again")
+	}
+
+	requireErrorDiag(t, resp.Diagnostics, "Immutable Attribute Error")
+}
+
+func TestResourceShardedCollectionPlanCollation(t *testing.T) {
+	ctx := context.Background()
+
+	_, s := newTestResource(t, ResourceShardedCollection, fake.New())
+
+	collation := "fr"
+	collection := testShardedCollection()
+	collection.Collation = &collation
+	collection.Timeouts = testTimeouts(s, nil)
+
+	req := planmodifier.StringRequest{
+		Path:        path.Root("collation"),
+		ConfigValue: tftypes.StringValue("simple"),
+		PlanValue:   tftypes.StringValue("simple"),
+		StateValue:  tftypes.StringValue(collation),
+		State:       newTestState(t, s, collection),
+	}
+	resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+
+	for _, m := range s.Attributes["collation"].(schema.StringAttribute).PlanModifiers {
+		m.PlanModifyString(ctx, req, resp)
+	}
+
+	requireErrorDiag(t, resp.Diagnostics, "Immutable Attribute Error")
+}
+
+func TestResourceShardedCollectionDelete(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceShardedCollection, client)
+
+	collection := testShardedCollection()
+	client.ShardCollection(collection)
+
+	collection.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, collection)
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %v", resp.Diagnostics)
+	}
+
+	if _, ok := client.GetShardedCollection("app.orders"); !ok {
+		t.Fatal("collection must stay sharded")
+	}
+}
+
+func TestResourceShardedCollectionImportState(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		wantErr bool
+	}{
+		{name: "imports collection", id: "app.orders"},
+		{name: "collection not sharded", id: "app.invoices", wantErr: true},
+		{name: "invalid id", id: "orders", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+
+			r, s := newTestResource(t, ResourceShardedCollection, client)
+
+			unique := true
+			collection := testShardedCollection()
+			collection.Unique = &unique
+			client.ShardCollection(collection)
+
+			resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+			r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to import sharded collection")
+				return
+			}
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			var got types.ShardedCollection
+			requireNoDiags(t, resp.State.Get(ctx, &got))
+
+			if got.Namespace() != tt.id || !got.Key.Equal(collection.Key) || got.Unique == nil || !*got.Unique {
+				t.Fatalf("expected collection %+v in state, got %+v", collection, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-mongodb/internal/mongoclient/interfaces"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &dataSourceSharding{}
+	_ datasource.DataSourceWithConfigure = &dataSourceSharding{}
+)
+
+func DataSourceSharding() datasource.DataSource {
+	return &dataSourceSharding{}
+}
+
+type dataSourceSharding struct {
+	client interfaces.Client
+}
+
+func (d *dataSourceSharding) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sharding"
+}
+
+func (d *dataSourceSharding) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the topology of a sharded cluster. The provider must be connected to a mongos.",
+		Attributes: map[string]schema.Attribute{
+			"shards": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The shards ordered by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the shard.",
+						},
+						"host": schema.StringAttribute{
+							Computed:    true,
+							Description: "The replica set of the shard in the <replica set>/<host:port>,<host:port> format.",
+						},
+						"state": schema.StringAttribute{
+							Computed:    true,
+							Description: "The state of the shard: `active`, or `draining` while it is being removed.",
+						},
+						"zones": schema.ListAttribute{
+							Computed:    true,
+							ElementType: tftypes.StringType,
+							Description: "The zones of the shard.",
+						},
+						"chunks": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of chunks on the shard.",
+						},
+					},
+				},
+			},
+			"databases": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The databases ordered by name, without the admin, config and local databases.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the database.",
+						},
+						"primary_shard": schema.StringAttribute{
+							Computed:    true,
+							Description: "The primary shard of the database, which stores its unsharded collections.",
+						},
+					},
+				},
+			},
+			"collections": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The sharded collections ordered by namespace, without the collections of the config database.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"database": schema.StringAttribute{
+							Computed:    true,
+							Description: "The database of the collection.",
+						},
+						"collection": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the collection.",
+						},
+						"key": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The fields of the shard key in order.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"field": schema.StringAttribute{
+										Computed:    true,
+										Description: "The name of the field.",
+									},
+									"type": schema.StringAttribute{
+										Computed:    true,
+										Description: "The type of the field: `ascending` or `hashed`.",
+									},
+								},
+							},
+						},
+						"unique": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the shard key index is unique.",
+						},
+						"balancing": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the balancer balances the collection.",
+						},
+						"chunks": schema.MapAttribute{
+							Computed:    true,
+							ElementType: tftypes.Int64Type,
+							Description: "The number of chunks of the collection by shard name.",
+						},
+					},
+				},
+			},
+			"balancer": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The status of the balancer.",
+				Attributes: map[string]schema.Attribute{
+					"mode": schema.StringAttribute{
+						Computed:    true,
+						Description: "The mode of the balancer: `full` when it runs, `off` when it is stopped.",
+					},
+					"in_balancer_round": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the balancer is moving chunks at the moment.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceSharding) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	state, err := d.client.DataSource().Sharding().Read(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read sharding", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (d *dataSourceSharding) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(interfaces.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected interfaces.Client, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestDataSourceShardingRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	client.AddShard("shard01", "rs1/db1:27017")
+	client.AddShard("shard02", "rs2/db2:27017")
+	client.AddShardZone("shard01", "EU")
+	client.SetPrimaryShard("app", "shard02")
+	client.ShardCollection(testShardedCollection())
+	client.SetChunks("app.orders", "shard01", 4)
+	client.SetBalancer(types.Balancer{Enabled: false})
+
+	d, state := newTestDataSource(t, DataSourceSharding, client)
+	resp := &datasource.ReadResponse{State: state}
+
+	d.Read(ctx, datasource.ReadRequest{}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.Sharding
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if len(got.Shards) != 2 || got.Shards[0].Chunks != 4 || len(got.Shards[0].Zones) != 1 || got.Shards[1].State != types.ShardStateActive {
+		t.Fatalf("expected shard01 with 4 chunks in zone EU and active shard02, got %+v", got.Shards)
+	}
+
+	if len(got.Databases) != 1 || got.Databases[0].PrimaryShard != "shard02" {
+		t.Fatalf("expected database app on shard02, got %+v", got.Databases)
+	}
+
+	if len(got.Collections) != 1 || !got.Collections[0].Balancing || got.Collections[0].Chunks["shard01"] != 4 {
+		t.Fatalf("expected balanced app.orders with 4 chunks on shard01, got %+v", got.Collections)
+	}
+
+	if got.Balancer.Mode != types.BalancerModeOff {
+		t.Fatalf("expected stopped balancer, got %+v", got.Balancer)
+	}
+}
+
+func TestDataSourceShardingReadError(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+	client.InjectError(fake.MethodDataSourceShardingRead, errors.New("not a mongos"))
+
+	d, state := newTestDataSource(t, DataSourceSharding, client)
+	resp := &datasource.ReadResponse{State: state}
+
+	d.Read(ctx, datasource.ReadRequest{}, resp)
+
+	requireErrorDiag(t, resp.Diagnostics, "Failed to read sharding")
+}
@@ -0,0 +1,15 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// nullTimeouts returns an unset timeouts value matching the timeouts attribute of the state schema.
+// The zero timeouts.Value has no attribute types and can't be written to state, e.g. on import.
+func nullTimeouts(state tfsdk.State) timeouts.Value {
+	t := state.Schema.GetAttributes()["timeouts"].GetType().(timeouts.Type)
+
+	return timeouts.Value{Object: types.ObjectNull(t.AttrTypes)}
+}
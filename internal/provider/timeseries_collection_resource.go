@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-mongodb/internal/mongoclient/interfaces"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ resource.Resource                = &resourceTimeSeriesCollection{}
+	_ resource.ResourceWithConfigure   = &resourceTimeSeriesCollection{}
+	_ resource.ResourceWithImportState = &resourceTimeSeriesCollection{}
+)
+
+func ResourceTimeSeriesCollection() resource.Resource {
+	return &resourceTimeSeriesCollection{}
+}
+
+type resourceTimeSeriesCollection struct {
+	client interfaces.Client
+}
+
+func (r *resourceTimeSeriesCollection) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_timeseries_collection"
+}
+
+func (r *resourceTimeSeriesCollection) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a time-series collection. Coarser granularities, larger buckets and the expiration are changed in place with collMod, " +
+			"other changes recreate the collection and drop its measurements. The definition is read back from listCollections, " +
+			"so changes made outside Terraform are detected. Requires MongoDB 5.0, the bucket settings require MongoDB 6.3.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The database of the collection.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The name of the collection.",
+			},
+			"time_field": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The field holding the date of each measurement. Changing it recreates the collection.",
+			},
+			"meta_field": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The field holding the metadata which identifies a series of measurements. Changing it recreates the collection.",
+			},
+			"granularity": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfFinerGranularity,
+						"Changing to a finer granularity recreates the collection",
+						"Changing to a finer granularity recreates the collection",
+					),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(types.TimeSeriesGranularities...),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("bucket_max_span_seconds"),
+						path.MatchRoot("bucket_rounding_seconds"),
+					),
+				},
+				Description: "The interval between measurements of a series, one of `seconds`, `minutes` or `hours`. " +
+					"Without the bucket settings the granularity is seconds. It may be changed to a coarser granularity in place, " +
+					"changing it to a finer one recreates the collection.",
+			},
+			"bucket_max_span_seconds": schema.Int64Attribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(
+						requiresReplaceIfSmallerBuckets,
+						"Adding, removing or decreasing the bucket settings recreates the collection",
+						"Adding, removing or decreasing the bucket settings recreates the collection",
+					),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 31536000),
+					int64validator.AlsoRequires(path.MatchRoot("bucket_rounding_seconds")),
+				},
+				Description: "The maximum time span of the measurements in a bucket, instead of the granularity. " +
+					"Must be equal to bucket_rounding_seconds. It may be increased in place, other changes recreate the collection.",
+			},
+			"bucket_rounding_seconds": schema.Int64Attribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(
+						requiresReplaceIfSmallerBuckets,
+						"Adding, removing or decreasing the bucket settings recreates the collection",
+						"Adding, removing or decreasing the bucket settings recreates the collection",
+					),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 31536000),
+					int64validator.AlsoRequires(path.MatchRoot("bucket_max_span_seconds")),
+				},
+				Description: "The interval the start time of a new bucket is rounded down to, instead of the granularity. " +
+					"Must be equal to bucket_max_span_seconds. It may be increased in place, other changes recreate the collection.",
+			},
+			"expire_after_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "The number of seconds after which measurements are deleted. By default they never expire.",
+			},
+			"retry": retryResourceAttribute(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// requiresReplaceIfFinerGranularity recreates the collection unless the granularity is changed to a coarser one,
+// which collMod supports. An unset granularity is seconds.
+func requiresReplaceIfFinerGranularity(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	current := types.TimeSeriesGranularitySeconds
+	if !req.StateValue.IsNull() {
+		current = req.StateValue.ValueString()
+	}
+
+	planned := types.TimeSeriesGranularitySeconds
+	if !req.PlanValue.IsNull() {
+		planned = req.PlanValue.ValueString()
+	}
+
+	resp.RequiresReplace = types.CompareTimeSeriesGranularities(planned, current) < 0
+}
+
+// requiresReplaceIfSmallerBuckets recreates the collection unless the bucket setting is increased,
+// which collMod supports.
+func requiresReplaceIfSmallerBuckets(_ context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() {
+		resp.RequiresReplace = true
+		return
+	}
+
+	resp.RequiresReplace = req.PlanValue.ValueInt64() < req.StateValue.ValueInt64()
+}
+
+func (r *resourceTimeSeriesCollection) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := types.TimeSeriesCollection{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	err := r.client.Resource().TimeSeriesCollection().Create(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create time-series collection", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceTimeSeriesCollection) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := types.TimeSeriesCollection{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	state, exist, err := r.client.Resource().TimeSeriesCollection().Read(apiCtx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read time-series collection", err.Error())
+		return
+	}
+
+	if !exist {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceTimeSeriesCollection) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := types.TimeSeriesCollection{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	err := r.client.Resource().TimeSeriesCollection().Update(apiCtx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update time-series collection", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceTimeSeriesCollection) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := types.TimeSeriesCollection{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.Resource().TimeSeriesCollection().Delete(apiCtx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete time-series collection", err.Error())
+		return
+	}
+}
+
+// ImportState imports the collection by the <database>.<collection> namespace.
+func (r *resourceTimeSeriesCollection) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	state, err := r.client.Resource().TimeSeriesCollection().ImportState(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import time-series collection", err.Error())
+		return
+	}
+
+	state.Timeouts = nullTimeouts(resp.State)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceTimeSeriesCollection) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(interfaces.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected interfaces.Client, got: %T.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testTimeSeriesCollection() types.TimeSeriesCollection {
+	meta, granularity := "sensor", types.TimeSeriesGranularityMinutes
+
+	return types.TimeSeriesCollection{
+		Database:    "metrics",
+		Name:        "weather",
+		TimeField:   "ts",
+		MetaField:   &meta,
+		Granularity: &granularity,
+	}
+}
+
+func TestResourceTimeSeriesCollectionCreate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceTimeSeriesCollection, client)
+
+	collection := testTimeSeriesCollection()
+	collection.Timeouts = testTimeouts(s, nil)
+	resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+	r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, collection)}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	got, ok := client.GetTimeSeriesCollection("metrics.weather")
+	if !ok || got.TimeField != "ts" || got.Granularity == nil || *got.Granularity != types.TimeSeriesGranularityMinutes {
+		t.Fatalf("expected collection %+v, got %+v", collection, got)
+	}
+
+	if !client.HasDatabase("metrics") {
+		t.Fatal("expected the database of the collection to be created")
+	}
+}
+
+func TestResourceTimeSeriesCollectionRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceTimeSeriesCollection, client)
+
+	expire, hours := int64(86400), types.TimeSeriesGranularityHours
+	changed := testTimeSeriesCollection()
+	changed.Granularity = &hours
+	changed.ExpireAfterSeconds = &expire
+	client.SetTimeSeriesCollection(changed)
+
+	collection := testTimeSeriesCollection()
+	collection.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, collection)
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.TimeSeriesCollection
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Granularity == nil || *got.Granularity != hours || got.ExpireAfterSeconds == nil || *got.ExpireAfterSeconds != expire {
+		t.Fatalf("expected hours granularity expiring after %d seconds in state, got %+v", expire, got)
+	}
+}
+
+func TestResourceTimeSeriesCollectionReadRemoved(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceTimeSeriesCollection, client)
+
+	collection := testTimeSeriesCollection()
+	collection.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, collection)
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if !resp.State.Raw.IsNull() {
+		t.Fatal("expected the dropped collection to be removed from state")
+	}
+}
+
+func TestResourceTimeSeriesCollectionUpdate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceTimeSeriesCollection, client)
+
+	client.SetTimeSeriesCollection(testTimeSeriesCollection())
+
+	state := testTimeSeriesCollection()
+	state.Timeouts = testTimeouts(s, nil)
+
+	expire, hours := int64(3600), types.TimeSeriesGranularityHours
+	plan := state
+	plan.Granularity = &hours
+	plan.ExpireAfterSeconds = &expire
+
+	resp := &resource.UpdateResponse{State: newTestState(t, s, state)}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: newTestPlan(t, s, plan), State: newTestState(t, s, state)}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	got, _ := client.GetTimeSeriesCollection("metrics.weather")
+	if got.Granularity == nil || *got.Granularity != hours || got.ExpireAfterSeconds == nil || *got.ExpireAfterSeconds != expire {
+		t.Fatalf("expected hours granularity expiring after %d seconds, got %+v", expire, got)
+	}
+}
+
+func TestResourceTimeSeriesCollectionUpdateFinerGranularity(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceTimeSeriesCollection, client)
+
+	client.SetTimeSeriesCollection(testTimeSeriesCollection())
+
+	state := testTimeSeriesCollection()
+	state.Timeouts = testTimeouts(s, nil)
+
+	seconds := types.TimeSeriesGranularitySeconds
+	plan := state
+	plan.Granularity = &seconds
+
+	resp := &resource.UpdateResponse{State: newTestState(t, s, state)}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: newTestPlan(t, s, plan), State: newTestState(t, s, state)}, resp)
+
+	requireErrorDiag(t, resp.Diagnostics, "Failed to update time-series collection")
+}
+
+func TestResourceTimeSeriesCollectionDelete(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceTimeSeriesCollection, client)
+
+	client.SetTimeSeriesCollection(testTimeSeriesCollection())
+
+	collection := testTimeSeriesCollection()
+	collection.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, collection)
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if _, ok := client.GetTimeSeriesCollection("metrics.weather"); ok {
+		t.Fatal("expected the collection to be dropped")
+	}
+}
+
+func TestResourceTimeSeriesCollectionImportState(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceTimeSeriesCollection, client)
+
+	client.SetTimeSeriesCollection(testTimeSeriesCollection())
+
+	resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "metrics.weather"}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.TimeSeriesCollection
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Database != "metrics" || got.Name != "weather" || got.TimeField != "ts" || got.MetaField == nil || *got.MetaField != "sensor" {
+		t.Fatalf("expected collection %+v in state, got %+v", testTimeSeriesCollection(), got)
+	}
+}
+
+func TestRequiresReplaceIfFinerGranularity(t *testing.T) {
+	tests := []struct {
+		name  string
+		state tftypes.String
+		plan  tftypes.String
+		want  bool
+	}{
+		{name: "coarser", state: tftypes.StringValue("seconds"), plan: tftypes.StringValue("hours")},
+		{name: "coarser than default", state: tftypes.StringNull(), plan: tftypes.StringValue("minutes")},
+		{name: "unset seconds", state: tftypes.StringValue("seconds"), plan: tftypes.StringNull()},
+		{name: "finer", state: tftypes.StringValue("hours"), plan: tftypes.StringValue("minutes"), want: true},
+		{name: "unset", state: tftypes.StringValue("minutes"), plan: tftypes.StringNull(), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &stringplanmodifier.RequiresReplaceIfFuncResponse{}
+
+			requiresReplaceIfFinerGranularity(context.Background(), planmodifier.StringRequest{StateValue: tt.state, PlanValue: tt.plan}, resp)
+
+			if resp.RequiresReplace != tt.want {
+				t.Fatalf("expected requires replace %t, got %t", tt.want, resp.RequiresReplace)
+			}
+		})
+	}
+}
+
+func TestRequiresReplaceIfSmallerBuckets(t *testing.T) {
+	tests := []struct {
+		name  string
+		state tftypes.Int64
+		plan  tftypes.Int64
+		want  bool
+	}{
+		{name: "larger", state: tftypes.Int64Value(3600), plan: tftypes.Int64Value(7200)},
+		{name: "smaller", state: tftypes.Int64Value(7200), plan: tftypes.Int64Value(3600), want: true},
+		{name: "added", state: tftypes.Int64Null(), plan: tftypes.Int64Value(3600), want: true},
+		{name: "removed", state: tftypes.Int64Value(3600), plan: tftypes.Int64Null(), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &int64planmodifier.RequiresReplaceIfFuncResponse{}
+
+			requiresReplaceIfSmallerBuckets(context.Background(), planmodifier.Int64Request{StateValue: tt.state, PlanValue: tt.plan}, resp)
+
+			if resp.RequiresReplace != tt.want {
+				t.Fatalf("expected requires replace %t, got %t", tt.want, resp.RequiresReplace)
+			}
+		})
+	}
+}
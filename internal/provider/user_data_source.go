@@ -67,7 +67,7 @@ func (d *dataSourceUsers) Read(ctx context.Context, _ datasource.ReadRequest, re
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state.ToDataSource())...)
 }
 
 func (d *dataSourceUsers) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/acctest"
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSourceUsersRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+	client.AddUser(types.User{Username: "admin"})
+	client.AddUser(testUser())
+
+	d, state := newTestDataSource(t, DataSourceUsers, client)
+	resp := &datasource.ReadResponse{State: state}
+
+	d.Read(ctx, datasource.ReadRequest{}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.DataSourceUsers
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if len(got.Users) != 1 || got.Users[0].Username != "app" || len(got.Users[0].Roles) != 1 {
+		t.Fatalf("expected user app with one role, got %v", got.Users)
+	}
+}
+
+func TestAccDataSourceUsers(t *testing.T) {
+	acctest.PreCheck(t)
+
+	cluster := acctest.StartStandalone(t)
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: cluster.ProviderConfig() + testAccResourceUserConfig("read") + `
+data "mongodb_users" "test" {
+  depends_on = [mongodb_user.test]
+}
+`,
+				Check: tfresource.TestCheckTypeSetElemNestedAttrs("data.mongodb_users.test", "users.*", map[string]string{
+					"username":         "acc_user",
+					"roles.0.role":     "read",
+					"roles.0.database": "acc_database",
+				}),
+			},
+		},
+	})
+}
@@ -63,6 +63,7 @@ func (r *resourceUser) Schema(ctx context.Context, _ resource.SchemaRequest, res
 					},
 				},
 			},
+			"retry": retryResourceAttribute(),
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
 				Read:   true,
@@ -140,7 +141,7 @@ func (r *resourceUser) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	if r.onlyTimeoutsChanged(plan, state) {
+	if r.onlyTimeoutsOrRetryChanged(plan, state) {
 		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 		return
 	}
@@ -196,6 +197,8 @@ func (r *resourceUser) ImportState(ctx context.Context, req resource.ImportState
 		return
 	}
 
+	state.Timeouts = nullTimeouts(resp.State)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -217,12 +220,15 @@ func (r *resourceUser) Configure(_ context.Context, req resource.ConfigureReques
 	r.client = client
 }
 
-func (r *resourceUser) onlyTimeoutsChanged(plan, state types.User) bool {
+func (r *resourceUser) onlyTimeoutsOrRetryChanged(plan, state types.User) bool {
 	cpPlan := plan
 	cpState := state
 
 	cpPlan.Timeouts = timeouts.Value{}
 	cpState.Timeouts = timeouts.Value{}
 
+	cpPlan.Retry = nil
+	cpState.Retry = nil
+
 	return reflect.DeepEqual(cpPlan, cpState)
 }
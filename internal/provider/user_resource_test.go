@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"terraform-provider-mongodb/internal/acctest"
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func testUser() types.User {
+	return types.User{
+		Username: "app",
+		Password: "secret",
+		Roles:    []types.Role{{Role: "readWrite", Database: "app"}},
+	}
+}
+
+func TestResourceUserCreate(t *testing.T) {
+	tests := []struct {
+		name     string
+		username string
+		existing bool
+		inject   error
+		wantErr  bool
+	}{
+		{name: "creates user", username: "app"},
+		{name: "user already exists", username: "app", existing: true, wantErr: true},
+		{name: "default user", username: "admin", wantErr: true},
+		{name: "client error", username: "app", inject: errors.New("not authorized"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+			client.InjectError(fake.MethodResourceUserCreate, tt.inject)
+
+			r, s := newTestResource(t, ResourceUser, client)
+
+			user := testUser()
+			user.Username = tt.username
+			if tt.existing {
+				client.AddUser(user)
+			}
+
+			user.Timeouts = testTimeouts(s, nil)
+			resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+			r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, user)}, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to create user")
+				return
+			}
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			got, ok := client.GetUser(tt.username)
+			if !ok {
+				t.Fatalf("user %s was not created", tt.username)
+			}
+
+			if len(got.Roles) != 1 || got.Roles[0] != user.Roles[0] {
+				t.Fatalf("expected roles %v, got %v", user.Roles, got.Roles)
+			}
+		})
+	}
+}
+
+func TestResourceUserRead(t *testing.T) {
+	tests := []struct {
+		name        string
+		existing    bool
+		inject      error
+		wantRemoved bool
+		wantErr     bool
+	}{
+		{name: "user exists", existing: true},
+		{name: "user removed outside of terraform", wantRemoved: true},
+		{name: "client error", existing: true, inject: errors.New("connection refused"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+			client.InjectError(fake.MethodResourceUserExists, tt.inject)
+
+			r, s := newTestResource(t, ResourceUser, client)
+
+			user := testUser()
+			if tt.existing {
+				client.AddUser(user)
+			}
+
+			user.Timeouts = testTimeouts(s, nil)
+			state := newTestState(t, s, user)
+			resp := &resource.ReadResponse{State: state}
+
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to check user existence")
+				return
+			}
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			if resp.State.Raw.IsNull() != tt.wantRemoved {
+				t.Fatalf("expected resource removed from state: %t, got: %t", tt.wantRemoved, resp.State.Raw.IsNull())
+			}
+		})
+	}
+}
+
+func TestResourceUserUpdate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceUser, client)
+
+	user := testUser()
+	client.AddUser(user)
+
+	user.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, user)
+
+	user.Roles = append(user.Roles, types.Role{Role: "read", Database: "reporting"})
+	plan := newTestPlan(t, s, user)
+
+	resp := &resource.UpdateResponse{State: state}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	got, _ := client.GetUser(user.Username)
+	if len(got.Roles) != 2 {
+		t.Fatalf("expected 2 roles after update, got %v", got.Roles)
+	}
+}
+
+func TestResourceUserUpdateOnlyRetry(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceUser, client)
+
+	user := testUser()
+	client.AddUser(user)
+
+	user.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, user)
+
+	attempts := int64(10)
+	user.Retry = &types.Retry{Attempts: &attempts}
+	plan := newTestPlan(t, s, user)
+
+	resp := &resource.UpdateResponse{State: state}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if calls := client.Calls(fake.MethodResourceUserUpdate); calls != 0 {
+		t.Fatalf("expected no update calls when only retry changed, got %d", calls)
+	}
+}
+
+func TestResourceUserDelete(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceUser, client)
+
+	user := testUser()
+	client.AddUser(user)
+
+	user.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, user)
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if _, ok := client.GetUser(user.Username); ok {
+		t.Fatal("user was not deleted")
+	}
+}
+
+func TestResourceUserImportState(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceUser, client)
+
+	user := testUser()
+	client.AddUser(user)
+
+	resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: user.Username}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.User
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Username != user.Username {
+		t.Fatalf("expected username %q in state, got %q", user.Username, got.Username)
+	}
+
+	if got.Password != "" {
+		t.Fatal("expected no password in imported state")
+	}
+}
+
+func TestAccResourceUser(t *testing.T) {
+	acctest.PreCheck(t)
+
+	cluster := acctest.StartStandalone(t)
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: cluster.ProviderConfig() + testAccResourceUserConfig("readWrite"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("mongodb_user.test", "username", "acc_user"),
+					tfresource.TestCheckResourceAttr("mongodb_user.test", "roles.#", "1"),
+					tfresource.TestCheckResourceAttr("mongodb_user.test", "roles.0.role", "readWrite"),
+				),
+			},
+			{
+				// MongoDB never returns passwords
+				ResourceName:                         "mongodb_user.test",
+				ImportState:                          true,
+				ImportStateId:                        "acc_user",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "username",
+				ImportStateVerifyIgnore:              []string{"password"},
+			},
+			{
+				Config: cluster.ProviderConfig() + testAccResourceUserConfig("read"),
+				Check:  tfresource.TestCheckResourceAttr("mongodb_user.test", "roles.0.role", "read"),
+			},
+			{
+				// The user is dropped outside of Terraform and must be planned for creation again
+				PreConfig: func() {
+					err := cluster.Client(t).Database("admin").RunCommand(context.Background(), bson.D{
+						{Key: "dropUser", Value: "acc_user"},
+					}).Err()
+					if err != nil {
+						t.Fatalf("failed to drop user: %s", err)
+					}
+				},
+				Config:             cluster.ProviderConfig() + testAccResourceUserConfig("read"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccResourceUserConfig(role string) string {
+	return fmt.Sprintf(`
+resource "mongodb_user" "test" {
+  username = "acc_user"
+  password = "acc_user_password"
+
+  roles = [
+    {
+      database = "acc_database"
+      role     = %q
+    }
+  ]
+}
+`, role)
+}
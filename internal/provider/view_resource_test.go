@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func testView() types.View {
+	return types.View{
+		Database: "app",
+		Name:     "active_orders",
+		ViewOn:   "orders",
+		Pipeline: `[{"$match":{"status":"active"}}]`,
+	}
+}
+
+func TestResourceViewCreate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceView, client)
+
+	view := testView()
+	view.Timeouts = testTimeouts(s, nil)
+	resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+	r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, view)}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	got, ok := client.GetView("app.active_orders")
+	if !ok || got.ViewOn != "orders" || got.Pipeline != view.Pipeline {
+		t.Fatalf("expected view %+v, got %+v", view, got)
+	}
+
+	if !client.HasDatabase("app") {
+		t.Fatal("expected the database of the view to be created")
+	}
+}
+
+func TestResourceViewCreateExisting(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceView, client)
+
+	client.SetView(testView())
+
+	view := testView()
+	view.Timeouts = testTimeouts(s, nil)
+	resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+	r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, view)}, resp)
+
+	requireErrorDiag(t, resp.Diagnostics, "Failed to create view")
+}
+
+func TestResourceViewRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceView, client)
+
+	changed := testView()
+	changed.Pipeline = `[{"$match":{"status":"archived"}}]`
+	client.SetView(changed)
+
+	view := testView()
+	view.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, view)
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.View
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Pipeline != changed.Pipeline {
+		t.Fatalf("expected pipeline %s in state, got %s", changed.Pipeline, got.Pipeline)
+	}
+}
+
+func TestResourceViewReadRemoved(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceView, client)
+
+	view := testView()
+	view.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, view)
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if !resp.State.Raw.IsNull() {
+		t.Fatal("expected the dropped view to be removed from state")
+	}
+}
+
+func TestResourceViewUpdate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceView, client)
+
+	client.SetView(testView())
+
+	state := testView()
+	state.Timeouts = testTimeouts(s, nil)
+	plan := state
+	plan.ViewOn = "archived_orders"
+	plan.Pipeline = `[]`
+
+	resp := &resource.UpdateResponse{State: newTestState(t, s, state)}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: newTestPlan(t, s, plan), State: newTestState(t, s, state)}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if got, _ := client.GetView("app.active_orders"); got.ViewOn != "archived_orders" || got.Pipeline != `[]` {
+		t.Fatalf("expected view on archived_orders with an empty pipeline, got %+v", got)
+	}
+}
+
+func TestResourceViewDelete(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceView, client)
+
+	client.SetView(testView())
+
+	view := testView()
+	view.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, view)
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if _, ok := client.GetView("app.active_orders"); ok {
+		t.Fatal("expected the view to be dropped")
+	}
+}
+
+func TestResourceViewImportState(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceView, client)
+
+	client.SetView(testView())
+
+	resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "app.active_orders"}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.View
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Database != "app" || got.Name != "active_orders" || got.ViewOn != "orders" {
+		t.Fatalf("expected view %+v in state, got %+v", testView(), got)
+	}
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-mongodb/internal/mongoclient/fake"
+	"terraform-provider-mongodb/internal/mongoclient/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func testZoneKeyRange() types.ZoneKeyRange {
+	return types.ZoneKeyRange{
+		Database:   "app",
+		Collection: "orders",
+		Zone:       "EU",
+		Min:        `{"tenant_id":"eu"}`,
+		Max:        `{"tenant_id":"eu~"}`,
+	}
+}
+
+func TestResourceZoneKeyRangeCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		sharded bool
+		min     string
+		wantErr bool
+	}{
+		{name: "creates range", sharded: true, min: `{"tenant_id":"eu"}`},
+		{name: "bound without shard key field", sharded: true, min: `{"region":"eu"}`, wantErr: true},
+		{name: "collection not sharded", min: `{"tenant_id":"eu"}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+
+			r, s := newTestResource(t, ResourceZoneKeyRange, client)
+
+			if tt.sharded {
+				client.ShardCollection(testShardedCollection())
+			}
+
+			zoneRange := testZoneKeyRange()
+			zoneRange.Min = tt.min
+			zoneRange.Timeouts = testTimeouts(s, nil)
+			resp := &resource.CreateResponse{State: emptyTestState(s)}
+
+			r.Create(ctx, resource.CreateRequest{Plan: newTestPlan(t, s, zoneRange)}, resp)
+
+			if tt.wantErr {
+				requireErrorDiag(t, resp.Diagnostics, "Failed to create zone key range")
+				return
+			}
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			if got, ok := client.GetZoneKeyRange("app.orders", tt.min); !ok || got.Zone != "EU" {
+				t.Fatalf("expected range in zone EU, got %+v", got)
+			}
+		})
+	}
+}
+
+func TestResourceZoneKeyRangeRead(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     *types.ZoneKeyRange
+		wantZone    string
+		wantRemoved bool
+	}{
+		{name: "range unchanged", current: &types.ZoneKeyRange{Zone: "EU"}, wantZone: "EU"},
+		{name: "range moved to another zone", current: &types.ZoneKeyRange{Zone: "US"}, wantZone: "US"},
+		{name: "range removed outside of terraform", wantRemoved: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.New()
+
+			r, s := newTestResource(t, ResourceZoneKeyRange, client)
+
+			if tt.current != nil {
+				current := testZoneKeyRange()
+				current.Zone = tt.current.Zone
+				client.AddZoneKeyRange(current)
+			}
+
+			zoneRange := testZoneKeyRange()
+			zoneRange.Timeouts = testTimeouts(s, nil)
+			state := newTestState(t, s, zoneRange)
+			resp := &resource.ReadResponse{State: state}
+
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			requireNoDiags(t, resp.Diagnostics)
+
+			if resp.State.Raw.IsNull() != tt.wantRemoved {
+				t.Fatalf("expected resource removed from state: %t, got: %t", tt.wantRemoved, resp.State.Raw.IsNull())
+			}
+
+			if tt.wantRemoved {
+				return
+			}
+
+			var got types.ZoneKeyRange
+			requireNoDiags(t, resp.State.Get(ctx, &got))
+
+			if got.Zone != tt.wantZone {
+				t.Fatalf("expected zone %s in state, got %s", tt.wantZone, got.Zone)
+			}
+		})
+	}
+}
+
+func TestResourceZoneKeyRangeDelete(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceZoneKeyRange, client)
+
+	zoneRange := testZoneKeyRange()
+	client.AddZoneKeyRange(zoneRange)
+
+	zoneRange.Timeouts = testTimeouts(s, nil)
+	state := newTestState(t, s, zoneRange)
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	if _, ok := client.GetZoneKeyRange("app.orders", zoneRange.Min); ok {
+		t.Fatal("range was not removed")
+	}
+}
+
+func TestResourceZoneKeyRangeImportState(t *testing.T) {
+	ctx := context.Background()
+	client := fake.New()
+
+	r, s := newTestResource(t, ResourceZoneKeyRange, client)
+
+	zoneRange := testZoneKeyRange()
+	client.AddZoneKeyRange(zoneRange)
+
+	resp := &resource.ImportStateResponse{State: emptyTestState(s)}
+
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "app.orders/" + zoneRange.Min}, resp)
+
+	requireNoDiags(t, resp.Diagnostics)
+
+	var got types.ZoneKeyRange
+	requireNoDiags(t, resp.State.Get(ctx, &got))
+
+	if got.Namespace() != "app.orders" || got.Zone != "EU" || got.Min != zoneRange.Min || got.Max != zoneRange.Max {
+		t.Fatalf("expected range %+v in state, got %+v", zoneRange, got)
+	}
+}